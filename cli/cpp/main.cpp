@@ -6,9 +6,11 @@
 #include <chrono>
 #include <cmath>
 #include <fstream>
+#include <iomanip>
 #include <iostream>
 #include <sstream>
 #include <string>
+#include <vector>
 
 struct RectangleData {
     double a, b, c, d;
@@ -20,6 +22,15 @@ double computeRectangleArea(const RectangleData& data) {
     return width * height;
 }
 
+RectangleData readRectangle(ryml::ConstNodeRef node) {
+    RectangleData data{0, 0, 0, 0};
+    if (node.has_child("a")) node["a"] >> data.a;
+    if (node.has_child("b")) node["b"] >> data.b;
+    if (node.has_child("c")) node["c"] >> data.c;
+    if (node.has_child("d")) node["d"] >> data.d;
+    return data;
+}
+
 int main(int argc, char* argv[]) {
     argparse::ArgumentParser program("rectangle");
     program.add_argument("yaml_file")
@@ -50,19 +61,28 @@ int main(int argc, char* argv[]) {
     ryml::Tree tree = ryml::parse_in_arena(ryml::to_csubstr(contents));
     ryml::ConstNodeRef root = tree.rootref();
 
-    RectangleData data;
-    root["a"] >> data.a;
-    root["b"] >> data.b;
-    root["c"] >> data.c;
-    root["d"] >> data.d;
+    // Either a single rectangle or a list of rectangles
+    std::vector<RectangleData> rectangles;
+    if (root.has_child("rectangles")) {
+        for (ryml::ConstNodeRef node : root["rectangles"].children()) {
+            rectangles.push_back(readRectangle(node));
+        }
+    } else {
+        rectangles.push_back(readRectangle(root));
+    }
 
-    double area = computeRectangleArea(data);
+    double area = 0;
+    for (const RectangleData& r : rectangles) {
+        area += computeRectangleArea(r);
+    }
 
     auto end = std::chrono::high_resolution_clock::now();
     auto elapsed = std::chrono::duration<double, std::milli>(end - start);
 
-    std::cout << "Rectangle area: " << std::fixed << area << std::endl;
-    std::cout << "Time: " << elapsed.count() << " ms" << std::endl;
+    std::cout << "Rectangles: " << rectangles.size() << std::endl;
+    std::cout << "Total area: " << std::fixed << std::setprecision(2) << area << std::endl;
+    std::cout << std::setprecision(6);
+    std::cout << "METRIC parse_time=" << elapsed.count() << " unit=ms" << std::endl;
 
     return 0;
 }
@@ -16,6 +16,12 @@ type RectangleData struct {
 	D float64 `yaml:"d"`
 }
 
+// Input is either a single rectangle or a list of rectangles
+type Input struct {
+	Rectangles    []RectangleData `yaml:"rectangles"`
+	RectangleData `yaml:",inline"`
+}
+
 func computeRectangleArea(data RectangleData) float64 {
 	width := abs(data.C - data.A)
 	height := abs(data.D - data.B)
@@ -32,7 +38,7 @@ func abs(x float64) float64 {
 func main() {
 	var rootCmd = &cobra.Command{
 		Use:   "rectangle [yaml-file]",
-		Short: "Calculate rectangle area from YAML file",
+		Short: "Calculate the total rectangle area from a YAML file",
 		Args:  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			yamlFile := args[0]
@@ -45,19 +51,27 @@ func main() {
 				os.Exit(1)
 			}
 
-			var data RectangleData
+			var data Input
 			err = yaml.Unmarshal(fileContents, &data)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error parsing YAML: %v\n", err)
 				os.Exit(1)
 			}
+			rectangles := data.Rectangles
+			if rectangles == nil {
+				rectangles = []RectangleData{data.RectangleData}
+			}
 
-			area := computeRectangleArea(data)
+			area := 0.0
+			for _, r := range rectangles {
+				area += computeRectangleArea(r)
+			}
 
 			elapsed := time.Since(start)
 
-			fmt.Printf("Rectangle area: %.2f\n", area)
-			fmt.Printf("Time: %.6f ms\n", float64(elapsed.Nanoseconds())/1e6)
+			fmt.Printf("Rectangles: %d\n", len(rectangles))
+			fmt.Printf("Total area: %.2f\n", area)
+			fmt.Printf("METRIC parse_time=%.6f unit=ms\n", float64(elapsed.Nanoseconds())/1e6)
 		},
 	}
 
@@ -1,9 +1,23 @@
+import org.yaml.snakeyaml.LoaderOptions;
 import org.yaml.snakeyaml.Yaml;
 import java.io.FileInputStream;
 import java.io.InputStream;
+import java.util.Collections;
+import java.util.List;
 import java.util.Map;
 
 public class Rectangle {
+    static double computeRectangleArea(Map<String, Object> data) {
+        double a = ((Number) data.getOrDefault("a", 0)).doubleValue();
+        double b = ((Number) data.getOrDefault("b", 0)).doubleValue();
+        double c = ((Number) data.getOrDefault("c", 0)).doubleValue();
+        double d = ((Number) data.getOrDefault("d", 0)).doubleValue();
+
+        double width = Math.abs(c - a);
+        double height = Math.abs(d - b);
+        return width * height;
+    }
+
     public static void main(String[] args) {
         if (args.length != 1) {
             System.err.println("Usage: java Rectangle <yaml-file>");
@@ -14,23 +28,30 @@ public class Rectangle {
         long startTime = System.nanoTime();
 
         try (InputStream inputStream = new FileInputStream(yamlFile)) {
-            Yaml yaml = new Yaml();
+            // Generated inputs go far beyond the default 3 MB limit
+            LoaderOptions options = new LoaderOptions();
+            options.setCodePointLimit(Integer.MAX_VALUE);
+            Yaml yaml = new Yaml(options);
             Map<String, Object> data = yaml.load(inputStream);
 
-            double a = ((Number) data.getOrDefault("a", 0)).doubleValue();
-            double b = ((Number) data.getOrDefault("b", 0)).doubleValue();
-            double c = ((Number) data.getOrDefault("c", 0)).doubleValue();
-            double d = ((Number) data.getOrDefault("d", 0)).doubleValue();
+            // Either a single rectangle or a list of rectangles
+            @SuppressWarnings("unchecked")
+            List<Map<String, Object>> rectangles = (List<Map<String, Object>>) data.get("rectangles");
+            if (rectangles == null) {
+                rectangles = Collections.singletonList(data);
+            }
 
-            double width = Math.abs(c - a);
-            double height = Math.abs(d - b);
-            double area = width * height;
+            double area = 0;
+            for (Map<String, Object> rectangle : rectangles) {
+                area += computeRectangleArea(rectangle);
+            }
 
             long endTime = System.nanoTime();
             double elapsedMs = (endTime - startTime) / 1_000_000.0;
 
-            System.out.printf("Rectangle area: %.2f%n", area);
-            System.out.printf("Time: %.6f ms%n", elapsedMs);
+            System.out.printf("Rectangles: %d%n", rectangles.size());
+            System.out.printf("Total area: %.2f%n", area);
+            System.out.printf("METRIC parse_time=%.6f unit=ms%n", elapsedMs);
 
         } catch (Exception e) {
             System.err.println("Error: " + e.getMessage());
@@ -22,7 +22,7 @@ function main() {
     
     program
         .name('rectangle')
-        .description('Calculate rectangle area from YAML file')
+        .description('Calculate the total rectangle area from a YAML file')
         .argument('<yaml-file>', 'Path to YAML file containing rectangle coordinates')
         .action((yamlFile) => {
             const start = performance.now();
@@ -30,12 +30,18 @@ function main() {
             const fileContents = fs.readFileSync(yamlFile, 'utf8');
             const data = yaml.load(fileContents);
             
-            const area = computeRectangleArea(data);
+            // Either a single rectangle or a list of rectangles
+            const rectangles = data.rectangles ?? [data];
+            let area = 0;
+            for (const r of rectangles) {
+                area += computeRectangleArea(r);
+            }
             
             const end = performance.now();
             
-            console.log(`Rectangle area: ${area}`);
-            console.log(`Time: ${(end - start).toFixed(6)} ms`);
+            console.log(`Rectangles: ${rectangles.length}`);
+            console.log(`Total area: ${area.toFixed(2)}`);
+            console.log(`METRIC parse_time=${(end - start).toFixed(6)} unit=ms`);
         });
     
     program.parse();
@@ -11,6 +11,11 @@ interface RectangleData {
     d?: number;
 }
 
+// Either a single rectangle or a list of rectangles
+interface Input extends RectangleData {
+    rectangles?: RectangleData[];
+}
+
 function computeRectangleArea(data: RectangleData): number {
     const a = data.a || 0;
     const b = data.b || 0;
@@ -29,20 +34,25 @@ function main(): void {
     
     program
         .name('rectangle')
-        .description('Calculate rectangle area from YAML file')
+        .description('Calculate the total rectangle area from a YAML file')
         .argument('<yaml-file>', 'Path to YAML file containing rectangle coordinates')
         .action((yamlFile: string) => {
             const start = performance.now();
             
             const fileContents = fs.readFileSync(yamlFile, 'utf8');
-            const data = yaml.load(fileContents) as RectangleData;
+            const data = yaml.load(fileContents) as Input;
             
-            const area = computeRectangleArea(data);
+            const rectangles = data.rectangles ?? [data];
+            let area = 0;
+            for (const r of rectangles) {
+                area += computeRectangleArea(r);
+            }
             
             const end = performance.now();
             
-            console.log(`Rectangle area: ${area}`);
-            console.log(`Time: ${(end - start).toFixed(6)} ms`);
+            console.log(`Rectangles: ${rectangles.length}`);
+            console.log(`Total area: ${area.toFixed(2)}`);
+            console.log(`METRIC parse_time=${(end - start).toFixed(6)} unit=ms`);
         });
     
     program.parse();
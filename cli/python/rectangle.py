@@ -16,7 +16,7 @@ def compute_rectangle_area(data):
     return area
 
 def main():
-    parser = argparse.ArgumentParser(description='Calculate rectangle area from YAML file')
+    parser = argparse.ArgumentParser(description='Calculate the total rectangle area from a YAML file')
     parser.add_argument('yaml_file', help='Path to YAML file containing rectangle coordinates')
     args = parser.parse_args()
     
@@ -25,12 +25,15 @@ def main():
     with open(args.yaml_file, 'r') as f:
         data = yaml.safe_load(f)
     
-    area = compute_rectangle_area(data)
+    # Either a single rectangle or a list of rectangles
+    rectangles = data.get('rectangles', [data])
+    area = sum(compute_rectangle_area(r) for r in rectangles)
     
     end = time.perf_counter()
     
-    print(f"Rectangle area: {area}")
-    print(f"Time: {(end - start) * 1000:.6f} ms")
+    print(f"Rectangles: {len(rectangles)}")
+    print(f"Total area: {area:.2f}")
+    print(f"METRIC parse_time={(end - start) * 1000:.6f} unit=ms")
 
 if __name__ == "__main__":
     main()
@@ -12,6 +12,14 @@ struct RectangleData {
     d: f64,
 }
 
+/// Either a list of rectangles or a single rectangle
+#[derive(Deserialize)]
+#[serde(untagged)]
+enum Input {
+    List { rectangles: Vec<RectangleData> },
+    Single(RectangleData),
+}
+
 fn compute_rectangle_area(data: &RectangleData) -> f64 {
     let width = (data.c - data.a).abs();
     let height = (data.d - data.b).abs();
@@ -20,7 +28,7 @@ fn compute_rectangle_area(data: &RectangleData) -> f64 {
 
 fn main() {
     let matches = Command::new("rectangle")
-        .about("Calculate rectangle area from YAML file")
+        .about("Calculate the total rectangle area from a YAML file")
         .arg(
             Arg::new("yaml-file")
                 .help("Path to YAML file containing rectangle coordinates")
@@ -35,12 +43,20 @@ fn main() {
 
     let file_contents = fs::read_to_string(yaml_file).expect("Error reading file");
 
-    let data: RectangleData = serde_yaml::from_str(&file_contents).expect("Error parsing YAML");
+    let data: Input = serde_yaml::from_str(&file_contents).expect("Error parsing YAML");
+    let rectangles = match data {
+        Input::List { rectangles } => rectangles,
+        Input::Single(rectangle) => vec![rectangle],
+    };
 
-    let area = compute_rectangle_area(&data);
+    let mut area = 0.0;
+    for rectangle in &rectangles {
+        area += compute_rectangle_area(rectangle);
+    }
 
     let elapsed = start.elapsed();
 
-    println!("Rectangle area: {:.2}", area);
-    println!("Time: {:.6} ms", elapsed.as_secs_f64() * 1000.0);
+    println!("Rectangles: {}", rectangles.len());
+    println!("Total area: {:.2}", area);
+    println!("METRIC parse_time={:.6} unit=ms", elapsed.as_secs_f64() * 1000.0);
 }
@@ -7,13 +7,33 @@ const RectangleData = struct {
     d: f64,
 };
 
-fn parseSimpleYaml(contents: []const u8) !RectangleData {
+const Totals = struct {
+    rectangles: usize,
+    area: f64,
+};
+
+// Reads either a single top-level rectangle or a "rectangles:" list, where
+// each "- " item starts a new rectangle. Only the a, b, c and d keys are
+// read; nested keys such as style are skipped.
+fn parseSimpleYaml(contents: []const u8) Totals {
+    var totals = Totals{ .rectangles = 0, .area = 0 };
     var data = RectangleData{ .a = 0, .b = 0, .c = 0, .d = 0 };
+    var in_list = false;
     var lines = std.mem.splitScalar(u8, contents, '\n');
 
     while (lines.next()) |line| {
-        const trimmed = std.mem.trim(u8, line, " \t\r");
-        if (trimmed.len == 0) continue;
+        var trimmed = std.mem.trim(u8, line, " \t\r");
+        if (trimmed.len == 0 or trimmed[0] == '#') continue;
+
+        if (std.mem.startsWith(u8, trimmed, "- ")) {
+            if (in_list) {
+                totals.rectangles += 1;
+                totals.area += computeRectangleArea(data);
+            }
+            in_list = true;
+            data = RectangleData{ .a = 0, .b = 0, .c = 0, .d = 0 };
+            trimmed = std.mem.trim(u8, trimmed[2..], " \t");
+        }
 
         if (std.mem.indexOfScalar(u8, trimmed, ':')) |colon_idx| {
             const key = std.mem.trim(u8, trimmed[0..colon_idx], " \t");
@@ -31,7 +51,9 @@ fn parseSimpleYaml(contents: []const u8) !RectangleData {
             }
         }
     }
-    return data;
+    totals.rectangles += 1;
+    totals.area += computeRectangleArea(data);
+    return totals;
 }
 
 fn computeRectangleArea(data: RectangleData) f64 {
@@ -57,17 +79,16 @@ pub fn main() !void {
 
     var timer = try std.time.Timer.start();
 
-    const file_contents = try std.fs.cwd().readFileAlloc(allocator, yaml_file, 1024 * 1024);
+    const file_contents = try std.fs.cwd().readFileAlloc(allocator, yaml_file, 1 << 30);
     defer allocator.free(file_contents);
 
-    const data = try parseSimpleYaml(file_contents);
-
-    const area = computeRectangleArea(data);
+    const totals = parseSimpleYaml(file_contents);
 
     const elapsed = timer.read();
     const elapsed_ms = @as(f64, @floatFromInt(elapsed)) / 1_000_000.0;
 
     const stdout = std.io.getStdOut().writer();
-    try stdout.print("Rectangle area: {d:.2}\n", .{area});
-    try stdout.print("Time: {d:.6} ms\n", .{elapsed_ms});
+    try stdout.print("Rectangles: {d}\n", .{totals.rectangles});
+    try stdout.print("Total area: {d:.2}\n", .{totals.area});
+    try stdout.print("METRIC parse_time={d:.6} unit=ms\n", .{elapsed_ms});
 }
@@ -1,153 +1,68 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
-	"path/filepath"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
-	"github.com/benchmarks/internal/benchmark"
 	"github.com/benchmarks/internal/builder"
 	"github.com/benchmarks/internal/config"
-	"github.com/benchmarks/internal/server"
+	"github.com/benchmarks/pkg/bench"
 	"github.com/spf13/cobra"
 )
 
-var (
-	connections int
-	pipeline    int
-	duration    int
-	baseDir     string
-	warmup      int
-	runs        int
-	benchMode   string
-)
-
-// getBenchmarkTool returns "poop" if available, otherwise "hyperfine"
-func getBenchmarkTool() (string, error) {
-	if _, err := exec.LookPath("poop"); err == nil {
-		return "poop", nil
-	}
-	if _, err := exec.LookPath("hyperfine"); err == nil {
-		return "hyperfine", nil
-	}
-	return "", fmt.Errorf("neither poop nor hyperfine found. Install poop or hyperfine")
-}
+var baseDir string
 
 func main() {
 	// Find base directory (repo root)
 	wd, _ := os.Getwd()
-	baseDir = findRepoRoot(wd)
+	baseDir = bench.FindRepoRoot(wd)
 
 	rootCmd := &cobra.Command{
 		Use:   "benchrunner",
 		Short: "HTTP benchmark orchestrator",
 		Long:  "Orchestrates HTTP benchmarks across multiple server implementations",
+
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := applyProfile(cmd); err != nil {
+				return err
+			}
+			return setOutput(cmd, args)
+		},
 	}
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputTable,
+		"Output format: table, json or ndjson (json and ndjson log to stderr)")
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "",
+		"Configuration file (default: benchrunner.yaml at the repository root, if present)")
+	rootCmd.PersistentFlags().StringVarP(&profileName, "profile", "P", "",
+		"Profile of the configuration file to use; flags override it")
+	rootCmd.PersistentFlags().StringVar(&resultsDir, "results-dir", "results",
+		"Directory results are saved in, relative to the repository root")
 
-	// Run command with subcommands
+	// Run command with one subcommand per registered suite
 	runCmd := &cobra.Command{
 		Use:   "run",
 		Short: "Run benchmarks",
 		Long:  "Run different types of benchmarks",
 	}
-
-	// Run server subcommand
-	runServerCmd := &cobra.Command{
-		Use:   "server [api-name]",
-		Short: "Run HTTP server benchmarks",
-		Long:  "Run benchmarks on all or selected HTTP servers",
-		RunE:  runServerBenchmarks,
-	}
-
-	runServerCmd.Flags().IntVarP(&connections, "connections", "c", 100, "Number of connections")
-	runServerCmd.Flags().IntVarP(&pipeline, "pipeline", "p", 1, "Pipeline factor")
-	runServerCmd.Flags().IntVarP(&duration, "duration", "d", 10, "Duration in seconds")
-
-	runCmd.AddCommand(runServerCmd)
-
-	// Run helloworld subcommand
-	runHelloworldCmd := &cobra.Command{
-		Use:   "helloworld [language]",
-		Short: "Run helloworld benchmarks",
-		Long: `Compile and benchmark helloworld programs in various languages using poop (or hyperfine as fallback).
-
-Modes:
-  compile    - Benchmark compilation time only (cold builds)
-  full-cold  - Benchmark compilation + execution (cold builds, no cache)
-  full-hot   - Benchmark compilation + execution (hot builds, cache allowed)
-  exec       - Benchmark execution time only (pre-compiled)`,
-		RunE: runHelloworldBenchmarks,
-	}
-	runHelloworldCmd.Flags().IntVarP(&warmup, "warmup", "w", 3, "Number of warmup runs")
-	runHelloworldCmd.Flags().IntVarP(&runs, "runs", "r", 10, "Number of benchmark runs")
-	runHelloworldCmd.Flags().StringVarP(&benchMode, "mode", "m", "exec", "Benchmark mode: compile, full-cold, full-hot, exec")
-
-	runCmd.AddCommand(runHelloworldCmd)
-
-	// Run compute subcommand
-	runComputeCmd := &cobra.Command{
-		Use:   "compute [language]",
-		Short: "Run compute (bubblesort) benchmarks",
-		Long: `Compile and benchmark bubblesort programs in various languages using poop (or hyperfine as fallback).
-
-Modes:
-  compile    - Benchmark compilation time only (cold builds)
-  full-cold  - Benchmark compilation + execution (cold builds, no cache)
-  full-hot   - Benchmark compilation + execution (hot builds, cache allowed)
-  exec       - Benchmark execution time only (pre-compiled)`,
-		RunE: runComputeBenchmarks,
-	}
-	runComputeCmd.Flags().IntVarP(&warmup, "warmup", "w", 3, "Number of warmup runs")
-	runComputeCmd.Flags().IntVarP(&runs, "runs", "r", 10, "Number of benchmark runs")
-	runComputeCmd.Flags().StringVarP(&benchMode, "mode", "m", "exec", "Benchmark mode: compile, full-cold, full-hot, exec")
-
-	runCmd.AddCommand(runComputeCmd)
-
-	// Run cli subcommand
-	runCLICmd := &cobra.Command{
-		Use:   "cli [language]",
-		Short: "Run CLI (rectangle YAML parsing) benchmarks",
-		Long: `Compile and benchmark rectangle YAML parsing programs in various languages using poop (or hyperfine as fallback).
-
-Modes:
-  compile    - Benchmark compilation time only (cold builds)
-  full-cold  - Benchmark compilation + execution (cold builds, no cache)
-  full-hot   - Benchmark compilation + execution (hot builds, cache allowed)
-  exec       - Benchmark execution time only (pre-compiled)`,
-		RunE: runCLIBenchmarks,
+	for _, info := range bench.Suites(baseDir) {
+		cmd, err := suiteCommand(info)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		runCmd.AddCommand(cmd)
 	}
-	runCLICmd.Flags().IntVarP(&warmup, "warmup", "w", 3, "Number of warmup runs")
-	runCLICmd.Flags().IntVarP(&runs, "runs", "r", 10, "Number of benchmark runs")
-	runCLICmd.Flags().StringVarP(&benchMode, "mode", "m", "exec", "Benchmark mode: compile, full-cold, full-hot, exec")
-
-	runCmd.AddCommand(runCLICmd)
-
-	// Run ffi subcommand
-	runFFICmd := &cobra.Command{
-		Use:   "ffi [language]",
-		Short: "Run FFI benchmarks (fast_sum and slow_compute)",
-		Long: `Compile and benchmark FFI programs in various languages using poop (or hyperfine as fallback).
-
-Runs two sub-benchmarks sequentially:
-  - fast_sum: measures FFI call overhead (1M calls of a simple sum function)
-  - slow_compute: measures compute-heavy FFI (100 calls with 1M iterations each)
-
-Modes:
-  compile    - Benchmark compilation time only (cold builds)
-  full-cold  - Benchmark compilation + execution (cold builds, no cache)
-  full-hot   - Benchmark compilation + execution (hot builds, cache allowed)
-  exec       - Benchmark execution time only (pre-compiled)`,
-		RunE: runFFIBenchmarks,
+	allCmd, err := allCommand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
-	runFFICmd.Flags().IntVarP(&warmup, "warmup", "w", 3, "Number of warmup runs")
-	runFFICmd.Flags().IntVarP(&runs, "runs", "r", 10, "Number of benchmark runs")
-	runFFICmd.Flags().StringVarP(&benchMode, "mode", "m", "exec", "Benchmark mode: compile, full-cold, full-hot, exec")
-
-	runCmd.AddCommand(runFFICmd)
+	runCmd.AddCommand(allCmd)
 
 	buildCmd := &cobra.Command{
 		Use:   "build",
@@ -158,840 +73,261 @@ Modes:
 
 	listCmd := &cobra.Command{
 		Use:   "list",
-		Short: "List available servers",
-		Long:  "List all available server implementations",
-		Run:   listServers,
+		Short: "List available servers and suites",
+		Long:  "List all available server implementations and benchmark suites with their variants",
+		RunE:  list,
 	}
+	listCmd.Flags().BoolVar(&listTags, "tags", false, "List every variant with its tags")
 
 	rootCmd.AddCommand(runCmd, buildCmd, listCmd)
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
-	}
-}
-
-func findRepoRoot(start string) string {
-	dir := start
-	for {
-		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
-			return dir
-		}
-		parent := filepath.Dir(dir)
-		if parent == dir {
-			return start
-		}
-		dir = parent
-	}
-}
-
-func buildBinary(cmd *cobra.Command, args []string) error {
-	b := builder.New(baseDir)
-	return b.Build()
-}
-
-func listServers(cmd *cobra.Command, args []string) {
-	servers := config.GetServers(baseDir)
-	fmt.Println("Available servers:")
-	for _, s := range servers {
-		fmt.Printf("  - %s\n", s.Name)
-	}
-}
-
-func runServerBenchmarks(cmd *cobra.Command, args []string) error {
-	// Parse server name from args
-	var targetServer string
-	if len(args) > 0 {
-		targetServer = args[0]
-	}
-	// Build binary first
-	b := builder.New(baseDir)
-	if err := b.Build(); err != nil {
-		return fmt.Errorf("failed to build binary: %w", err)
-	}
-
-	// Get servers to benchmark
-	var serversToRun []config.ServerConfig
-	allServers := config.GetServers(baseDir)
-
-	if targetServer == "" {
-		serversToRun = allServers
-	} else {
-		if srv := config.GetServerByName(baseDir, targetServer); srv != nil {
-			serversToRun = append(serversToRun, *srv)
-		} else {
-			return fmt.Errorf("unknown server: %s", targetServer)
+	ctx := interruptContext()
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		if ctx.Err() != nil {
+			os.Exit(130)
 		}
+		os.Exit(1)
 	}
-
-	if len(serversToRun) == 0 {
-		return fmt.Errorf("no servers to benchmark")
-	}
-
-	// Run benchmarks
-	runner := benchmark.NewRunner(b.GetBinaryPath(), connections, pipeline, duration)
-	var results []*benchmark.Result
-
-	for _, srvCfg := range serversToRun {
-		srv := server.New(&srvCfg)
-		
-		// Start server
-		if err := srv.Start(); err != nil {
-			fmt.Printf("ERROR: %v\n", err)
-			results = append(results, &benchmark.Result{
-				ServerName: srvCfg.Name,
-				Error:      err.Error(),
-			})
-			continue
-		}
-
-		// Run benchmark
-		result, err := runner.Run(srvCfg.Name, srvCfg.Port, srv.GetPID())
-		if err != nil {
-			fmt.Printf("WARNING: Benchmark failed: %v\n", err)
-		}
-		results = append(results, result)
-
-		// Stop server
-		srv.Stop()
-
-		// Wait between benchmarks (ensure port is fully released)
-		time.Sleep(5 * time.Second)
-	}
-
-	// Print summary
-	printSummary(results)
-
-	// Save results
-	if err := saveResults(results); err != nil {
-		fmt.Printf("WARNING: Failed to save results: %v\n", err)
-	}
-
-	return nil
 }
 
-func printSummary(results []*benchmark.Result) {
-	fmt.Println("\n" + strings.Repeat("=", 80))
-	fmt.Println("BENCHMARK SUMMARY")
-	fmt.Println(strings.Repeat("=", 80))
-	fmt.Printf("%-20s %15s %15s %15s\n", "Server", "Req/sec", "Memory (MB)", "Status")
-	fmt.Println(strings.Repeat("-", 80))
-
-	for _, r := range results {
-		status := "OK"
-		reqPerSec := fmt.Sprintf("%.2f", r.ReqPerSec)
-		memory := fmt.Sprintf("%.2f", r.MemoryMB)
-		if r.Error != "" {
-			status = "FAILED"
-			reqPerSec = "N/A"
-			memory = "N/A"
-		}
-		fmt.Printf("%-20s %15s %15s %15s\n", r.ServerName, reqPerSec, memory, status)
-	}
-	fmt.Println(strings.Repeat("=", 80))
+// interruptContext returns a context that is cancelled on SIGINT or
+// SIGTERM, so that a run stops its servers and programs, saves what it
+// measured and cleans up. A second signal terminates right away.
+func interruptContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigs
+		signal.Stop(sigs)
+		fmt.Fprintf(os.Stderr, "\nReceived %s, stopping and cleaning up (repeat to exit immediately)\n", sig)
+		cancel()
+	}()
+	return ctx
 }
 
-func saveResults(results []*benchmark.Result) error {
-	resultsDir := filepath.Join(baseDir, "results")
-	os.MkdirAll(resultsDir, 0755)
-
-	filename := fmt.Sprintf("benchmark_%s.json", time.Now().Format("20060102_150405"))
-	filepath := filepath.Join(resultsDir, filename)
-
-	data, err := json.MarshalIndent(results, "", "  ")
+// suiteCommand returns the run subcommand of a suite, with the flags the
+// suite takes
+func suiteCommand(info bench.SuiteInfo) (*cobra.Command, error) {
+	cfg, err := bench.DefaultConfig(info.Name)
 	if err != nil {
-		return err
-	}
-
-	if err := os.WriteFile(filepath, data, 0644); err != nil {
-		return err
-	}
-
-	fmt.Printf("\nResults saved to: %s\n", filepath)
-	return nil
-}
-
-// helloworldLang defines a language's build and run configuration
-type helloworldLang struct {
-	name       string
-	dir        string
-	compileCmd string   // command to compile
-	runCmd     string   // command to run the binary
-	binaryPath string   // path to the compiled binary (relative to dir)
-	cleanCmd   string   // command to clean build artifacts
-	cleanFiles []string // files/dirs to remove for cold builds
-	fullHotCmd string   // optional: command for full-hot mode (e.g., go run)
-}
-
-func getHelloworldLanguages(baseDir string) []helloworldLang {
-	hwDir := filepath.Join(baseDir, "helloworld")
-	return []helloworldLang{
-		// C variants
-		{
-			name:       "c-cmake",
-			dir:        filepath.Join(hwDir, "c"),
-			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build",
-			runCmd:     "./build/hello",
-			binaryPath: "build/hello",
-			cleanCmd:   "rm -rf build",
-			cleanFiles: []string{"build"},
-		},
-		{
-			name:       "c-direct",
-			dir:        filepath.Join(hwDir, "c"),
-			compileCmd: "gcc -O3 -flto -march=native -DNDEBUG -s -o hello main.c",
-			runCmd:     "./hello",
-			binaryPath: "hello",
-			cleanCmd:   "rm -f hello",
-			cleanFiles: []string{"hello"},
-		},
-		// C++ variants
-		{
-			name:       "cpp-cmake",
-			dir:        filepath.Join(hwDir, "cpp"),
-			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build",
-			runCmd:     "./build/hello",
-			binaryPath: "build/hello",
-			cleanCmd:   "rm -rf build",
-			cleanFiles: []string{"build"},
-		},
-		{
-			name:       "cpp-direct",
-			dir:        filepath.Join(hwDir, "cpp"),
-			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o hello main.cpp",
-			runCmd:     "./hello",
-			binaryPath: "hello",
-			cleanCmd:   "rm -f hello",
-			cleanFiles: []string{"hello"},
-		},
-		// Go (already direct)
-		{
-			name:       "go",
-			dir:        filepath.Join(hwDir, "go"),
-			compileCmd: "go build -ldflags=\"-s -w\" -o hello main.go",
-			runCmd:     "./hello",
-			binaryPath: "hello",
-			cleanCmd:   "rm -f hello",
-			cleanFiles: []string{"hello"},
-			fullHotCmd: "go run main.go",
-		},
-		// Rust variants
-		{
-			name:       "rust-cargo",
-			dir:        filepath.Join(hwDir, "rust"),
-			compileCmd: "cargo build --release",
-			runCmd:     "./target/release/hello",
-			binaryPath: "target/release/hello",
-			cleanCmd:   "cargo clean",
-			cleanFiles: []string{"target"},
-		},
-		{
-			name:       "rust-direct",
-			dir:        filepath.Join(hwDir, "rust"),
-			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o hello src/main.rs",
-			runCmd:     "./hello",
-			binaryPath: "hello",
-			cleanCmd:   "rm -f hello",
-			cleanFiles: []string{"hello"},
-		},
-		// Zig variants
-		{
-			name:       "zig-build",
-			dir:        filepath.Join(hwDir, "zig"),
-			compileCmd: "zig build -Doptimize=ReleaseFast",
-			runCmd:     "./zig-out/bin/hello",
-			binaryPath: "zig-out/bin/hello",
-			cleanCmd:   "rm -rf zig-out .zig-cache",
-			cleanFiles: []string{"zig-out", ".zig-cache"},
-		},
-		{
-			name:       "zig-direct",
-			dir:        filepath.Join(hwDir, "zig"),
-			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=hello main.zig",
-			runCmd:     "./hello",
-			binaryPath: "hello",
-			cleanCmd:   "rm -f hello hello.o",
-			cleanFiles: []string{"hello", "hello.o"},
-		},
-		// Interpreted languages
-		{
-			name:   "nodejs-direct",
-			dir:    filepath.Join(hwDir, "node"),
-			runCmd: "node main.js",
-		},
-		{
-			name:       "nodejs-build",
-			dir:        filepath.Join(hwDir, "node"),
-			compileCmd: "npx esbuild main.js --bundle --minify --platform=node --format=esm --outfile=main.min.js",
-			runCmd:     "node main.min.js",
-			binaryPath: "main.min.js",
-			cleanCmd:   "rm -f main.min.js",
-			cleanFiles: []string{"main.min.js"},
-		},
-		{
-			name:       "nodets-direct",
-			dir:        filepath.Join(hwDir, "node"),
-			compileCmd: "npx tsc",
-			runCmd:     "node dist/main.js",
-			binaryPath: "dist/main.js",
-			cleanCmd:   "rm -rf dist",
-			cleanFiles: []string{"dist"},
-		},
-		{
-			name:       "nodets-build",
-			dir:        filepath.Join(hwDir, "node"),
-			compileCmd: "npx tsc --noEmit && npx esbuild main.ts --bundle --minify --platform=node --format=esm --outfile=main.min.js",
-			runCmd:     "node main.min.js",
-			binaryPath: "main.min.js",
-			cleanCmd:   "rm -f main.min.js",
-			cleanFiles: []string{"main.min.js"},
-		},
-		{
-			name:   "python",
-			dir:    filepath.Join(hwDir, "python"),
-			runCmd: "python3 main.py",
-		},
-		{
-			name:       "java",
-			dir:        filepath.Join(hwDir, "java"),
-			compileCmd: "javac Main.java",
-			runCmd:     "java Main",
-			binaryPath: "Main.class",
-			cleanCmd:   "rm -f *.class",
-			cleanFiles: []string{"Main.class"},
-		},
+		return nil, err
 	}
-}
-
-func runHelloworldBenchmarks(cmd *cobra.Command, args []string) error {
-	return runGenericBenchmarks("helloworld", getHelloworldLanguages(baseDir), args)
-}
-
-// ============================================================================
-// COMPUTE (Bubblesort) Benchmarks
-// ============================================================================
-
-func getComputeLanguages(baseDir string) []helloworldLang {
-	computeDir := filepath.Join(baseDir, "compute")
-	return []helloworldLang{
-		{
-			name:       "go",
-			dir:        filepath.Join(computeDir, "go"),
-			compileCmd: "go build -ldflags=\"-s -w\" -o bubblesort bubblesort.go",
-			runCmd:     "./bubblesort",
-			binaryPath: "bubblesort",
-			cleanCmd:   "rm -f bubblesort",
-			cleanFiles: []string{"bubblesort"},
-			fullHotCmd: "go run bubblesort.go",
-		},
-		{
-			name:       "rust",
-			dir:        filepath.Join(computeDir, "rust"),
-			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o bubblesort bubblesort.rs",
-			runCmd:     "./bubblesort",
-			binaryPath: "bubblesort",
-			cleanCmd:   "rm -f bubblesort",
-			cleanFiles: []string{"bubblesort"},
-		},
-		{
-			name:       "zig",
-			dir:        filepath.Join(computeDir, "zig"),
-			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=bubblesort bubblesort.zig",
-			runCmd:     "./bubblesort",
-			binaryPath: "bubblesort",
-			cleanCmd:   "rm -f bubblesort bubblesort.o",
-			cleanFiles: []string{"bubblesort", "bubblesort.o"},
-		},
-		{
-			name:   "nodejs-direct",
-			dir:    filepath.Join(computeDir, "node"),
-			runCmd: "node bubblesort.js",
-		},
-		{
-			name:       "nodejs-build",
-			dir:        filepath.Join(computeDir, "node"),
-			compileCmd: "npx esbuild bubblesort.js --bundle --minify --platform=node --format=esm --outfile=bubblesort.min.js",
-			runCmd:     "node bubblesort.min.js",
-			binaryPath: "bubblesort.min.js",
-			cleanCmd:   "rm -f bubblesort.min.js",
-			cleanFiles: []string{"bubblesort.min.js"},
-		},
-		{
-			name:       "nodets-direct",
-			dir:        filepath.Join(computeDir, "node"),
-			compileCmd: "npx tsc",
-			runCmd:     "node dist/bubblesort.js",
-			binaryPath: "dist/bubblesort.js",
-			cleanCmd:   "rm -rf dist",
-			cleanFiles: []string{"dist"},
-		},
-		{
-			name:       "nodets-build",
-			dir:        filepath.Join(computeDir, "node"),
-			compileCmd: "npx tsc --noEmit && npx esbuild bubblesort.ts --bundle --minify --platform=node --format=esm --outfile=bubblesort.min.js",
-			runCmd:     "node bubblesort.min.js",
-			binaryPath: "bubblesort.min.js",
-			cleanCmd:   "rm -f bubblesort.min.js",
-			cleanFiles: []string{"bubblesort.min.js"},
-		},
-		{
-			name:   "python",
-			dir:    filepath.Join(computeDir, "python"),
-			runCmd: "python3 bubblesort.py",
-		},
-	}
-}
-
-func runComputeBenchmarks(cmd *cobra.Command, args []string) error {
-	return runGenericBenchmarks("compute", getComputeLanguages(baseDir), args)
-}
-
-// ============================================================================
-// CLI (Rectangle YAML parsing) Benchmarks
-// ============================================================================
-
-func getCLILanguages(baseDir string) []helloworldLang {
-	cliDir := filepath.Join(baseDir, "cli")
-	yamlFile := "../test_rectangle.yaml"
-	return []helloworldLang{
-		{
-			name:       "cpp",
-			dir:        filepath.Join(cliDir, "cpp"),
-			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build -j$(nproc)",
-			runCmd:     "./build/rectangle " + yamlFile,
-			binaryPath: "build/rectangle",
-			cleanCmd:   "rm -rf build",
-			cleanFiles: []string{"build"},
-		},
-		{
-			name:       "go",
-			dir:        filepath.Join(cliDir, "go"),
-			compileCmd: "go build -ldflags=\"-s -w\" -o rectangle rectangle.go",
-			runCmd:     "./rectangle " + yamlFile,
-			binaryPath: "rectangle",
-			cleanCmd:   "rm -f rectangle",
-			cleanFiles: []string{"rectangle"},
-			fullHotCmd: "go run rectangle.go " + yamlFile,
-		},
-		{
-			name:       "rust",
-			dir:        filepath.Join(cliDir, "rust"),
-			compileCmd: "cargo build --release",
-			runCmd:     "./target/release/rectangle " + yamlFile,
-			binaryPath: "target/release/rectangle",
-			cleanCmd:   "cargo clean",
-			cleanFiles: []string{"target"},
-		},
-		{
-			name:       "zig",
-			dir:        filepath.Join(cliDir, "zig"),
-			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=rectangle rectangle.zig",
-			runCmd:     "./rectangle " + yamlFile,
-			binaryPath: "rectangle",
-			cleanCmd:   "rm -f rectangle rectangle.o",
-			cleanFiles: []string{"rectangle", "rectangle.o"},
-		},
-		{
-			name:   "nodejs-direct",
-			dir:    filepath.Join(cliDir, "node"),
-			runCmd: "node rectangle.js " + yamlFile,
-		},
-		{
-			name:       "nodejs-build",
-			dir:        filepath.Join(cliDir, "node"),
-			compileCmd: "npx esbuild rectangle.js --bundle --minify --platform=node --format=cjs --outfile=rectangle.min.cjs",
-			runCmd:     "node rectangle.min.cjs " + yamlFile,
-			binaryPath: "rectangle.min.cjs",
-			cleanCmd:   "rm -f rectangle.min.cjs",
-			cleanFiles: []string{"rectangle.min.cjs"},
-		},
-		{
-			name:       "nodets-direct",
-			dir:        filepath.Join(cliDir, "node"),
-			compileCmd: "npx tsc",
-			runCmd:     "node dist/rectangle.js " + yamlFile,
-			binaryPath: "dist/rectangle.js",
-			cleanCmd:   "rm -rf dist",
-			cleanFiles: []string{"dist"},
-		},
-		{
-			name:       "nodets-build",
-			dir:        filepath.Join(cliDir, "node"),
-			compileCmd: "npx tsc --noEmit && npx esbuild rectangle.ts --bundle --minify --platform=node --format=cjs --outfile=rectangle.min.cjs",
-			runCmd:     "node rectangle.min.cjs " + yamlFile,
-			binaryPath: "rectangle.min.cjs",
-			cleanCmd:   "rm -f rectangle.min.cjs",
-			cleanFiles: []string{"rectangle.min.cjs"},
-		},
-		{
-			name:   "python",
-			dir:    filepath.Join(cliDir, "python"),
-			runCmd: "python3 rectangle.py " + yamlFile,
-		},
-		{
-			name:       "java",
-			dir:        filepath.Join(cliDir, "java"),
-			compileCmd: "javac -cp snakeyaml.jar Rectangle.java",
-			runCmd:     "java -cp .:snakeyaml.jar Rectangle " + yamlFile,
-			binaryPath: "Rectangle.class",
-			cleanCmd:   "rm -f *.class",
-			cleanFiles: []string{"Rectangle.class"},
+	cfg.BaseDir = baseDir
+	fs, err := bench.FlagSet(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   info.Name + " " + info.Args,
+		Short: info.Short,
+		Long:  info.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Failures from here on are not usage errors
+			cmd.SilenceUsage = true
+			cfg.Targets = args
+			cfg.ResultsDir = resultsPath()
+			cfg.Log = logOutput()
+			if dryRun {
+				plan, err := bench.MakePlan(cfg)
+				if err != nil {
+					return err
+				}
+				return printPlan(plan)
+			}
+			return runSuite(cmd.Context(), cfg)
 		},
 	}
+	cmd.Flags().AddFlagSet(fs)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"Print the variants, commands and benchmark tool invocation the run would execute, without executing anything")
+	return cmd, nil
 }
 
-func runCLIBenchmarks(cmd *cobra.Command, args []string) error {
-	return runGenericBenchmarks("cli", getCLILanguages(baseDir), args)
-}
-
-// ============================================================================
-// FFI Benchmarks
-// ============================================================================
-
-func getFFIFastSumLanguages(baseDir string) []helloworldLang {
-	ffiDir := filepath.Join(baseDir, "ffi", "fast_sum")
-	return []helloworldLang{
-		{
-			name:       "cpp",
-			dir:        filepath.Join(ffiDir, "cpp"),
-			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o main main.cpp ../hotpath.cpp",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main",
-			cleanFiles: []string{"main"},
-		},
-		{
-			name:       "rust",
-			dir:        filepath.Join(ffiDir, "rust"),
-			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o main main.rs",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main",
-			cleanFiles: []string{"main"},
-		},
-		{
-			name:       "zig",
-			dir:        filepath.Join(ffiDir, "zig"),
-			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=main main.zig",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main main.o",
-			cleanFiles: []string{"main", "main.o"},
-		},
-		{
-			name:   "python",
-			dir:    filepath.Join(ffiDir, "python"),
-			runCmd: "python3 main.py",
+// allCommand returns the run subcommand that runs several suites in a row
+func allCommand() (*cobra.Command, error) {
+	cfg, err := bench.DefaultAllConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.BaseDir = baseDir
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "all [language]",
+		Short: "Run several suites in a row with one consolidated report",
+		Long: `Run every suite, or those of --suites in the given order, with the same
+options and language filter, and save one report covering all of them as
+results/all_<timestamp>.json.
+
+A suite that fails doesn't stop the ones after it, and a suite with no
+variant matching the filter is skipped. The report has a section per suite
+with its results, and a summary of the suites and variants that failed or
+were skipped. Suites' own flags are set with --set suite.flag=value.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cfg.Targets = args
+			cfg.ResultsDir = resultsPath()
+			cfg.Log = logOutput()
+			profileSettings(&cfg)
+			if dryRun {
+				plans, err := bench.MakePlans(cfg)
+				if err != nil {
+					return err
+				}
+				if outputFormat != outputTable {
+					return writeJSON(plans)
+				}
+				for _, plan := range plans {
+					if err := printPlan(plan); err != nil {
+						return err
+					}
+					fmt.Println()
+				}
+				return nil
+			}
+			return runAll(cmd.Context(), cfg)
 		},
 	}
+	cmd.Flags().AddFlagSet(bench.AllFlagSet(&cfg))
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"Print the variants, commands and benchmark tool invocations the suites would execute, without executing anything")
+	return cmd, nil
 }
 
-func getFFISlowComputeLanguages(baseDir string) []helloworldLang {
-	ffiDir := filepath.Join(baseDir, "ffi", "slow_compute")
-	return []helloworldLang{
-		{
-			name:       "cpp",
-			dir:        filepath.Join(ffiDir, "cpp"),
-			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o main main.cpp ../hotpath.cpp",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main",
-			cleanFiles: []string{"main"},
-		},
-		{
-			name:       "rust",
-			dir:        filepath.Join(ffiDir, "rust"),
-			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o main main.rs",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main",
-			cleanFiles: []string{"main"},
-		},
-		{
-			name:       "zig",
-			dir:        filepath.Join(ffiDir, "zig"),
-			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=main main.zig",
-			runCmd:     "./main",
-			binaryPath: "main",
-			cleanCmd:   "rm -f main main.o",
-			cleanFiles: []string{"main", "main.o"},
-		},
-		{
-			name:   "python",
-			dir:    filepath.Join(ffiDir, "python"),
-			runCmd: "python3 main.py",
-		},
+// runAll runs several suites and prints the report: every event as it
+// happens with --output ndjson, the report with --output json, and a
+// table per suite otherwise. It fails if a suite failed.
+func runAll(ctx context.Context, cfg bench.AllConfig) error {
+	if outputFormat == outputNDJSON {
+		cfg.Progress = func(e bench.Event) {
+			if err := writeJSON(e); err != nil {
+				fmt.Fprintf(os.Stderr, "WARNING: failed to write event: %v\n", err)
+			}
+		}
 	}
-}
-
-func runFFIBenchmarks(cmd *cobra.Command, args []string) error {
-	fmt.Println("Running FFI benchmarks (two sub-benchmarks)")
-	fmt.Println(strings.Repeat("=", 80))
 
-	// Run fast_sum benchmark
-	fmt.Println("\n[1/2] fast_sum - FFI call overhead benchmark")
-	fmt.Println(strings.Repeat("-", 80))
-	if err := runGenericBenchmarks("ffi/fast_sum", getFFIFastSumLanguages(baseDir), args); err != nil {
+	report, err := bench.RunAll(ctx, cfg)
+	if report == nil {
 		return err
 	}
-
-	// Run slow_compute benchmark
-	fmt.Println("\n[2/2] slow_compute - compute-heavy FFI benchmark")
-	fmt.Println(strings.Repeat("-", 80))
-	if err := runGenericBenchmarks("ffi/slow_compute", getFFISlowComputeLanguages(baseDir), args); err != nil {
-		return err
+	switch outputFormat {
+	case outputJSON:
+		if writeErr := writeJSON(report); writeErr != nil && err == nil {
+			err = writeErr
+		}
+	case outputTable:
+		printReport(report)
 	}
-
-	return nil
-}
-
-// ============================================================================
-// Generic Benchmark Runner
-// ============================================================================
-
-func runGenericBenchmarks(suiteName string, languages []helloworldLang, args []string) error {
-	// Validate mode
-	validModes := map[string]bool{"compile": true, "full-cold": true, "full-hot": true, "exec": true}
-	if !validModes[benchMode] {
-		return fmt.Errorf("invalid mode: %s (valid: compile, full-cold, full-hot, exec)", benchMode)
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("interrupted")
 	}
-
-	// Check for benchmark tool
-	benchTool, err := getBenchmarkTool()
 	if err != nil {
 		return err
 	}
-
-	// Build set of target languages from args (supports multiple: "go,rust,zig" or "go" "rust" "zig")
-	targetLangs := make(map[string]bool)
-	for _, arg := range args {
-		for _, lang := range strings.Split(arg, ",") {
-			lang = strings.TrimSpace(strings.ToLower(lang))
-			if lang != "" {
-				targetLangs[lang] = true
-			}
-		}
+	if failed := report.Summary.Suites[bench.StatusFailed]; failed > 0 {
+		return fmt.Errorf("%d of %d suites failed", failed, len(report.Suites))
 	}
+	return nil
+}
 
-	// matchesTarget checks if a language name matches any target (exact or prefix match)
-	// Special handling: "node" matches all nodejs-* and nodets-* variants
-	matchesTarget := func(langName string) bool {
-		if len(targetLangs) == 0 {
-			return true // No filter, run all
-		}
-		if targetLangs[langName] {
-			return true // Exact match
+// runSuite runs a suite. With --output ndjson every event of the run is
+// printed as it happens; with --output json, the summary at the end.
+func runSuite(ctx context.Context, cfg bench.Config) error {
+	var summary *bench.Event
+	cfg.Progress = func(e bench.Event) {
+		if e.Kind == bench.EventSummary {
+			summary = &e
 		}
-		// Check prefix match (e.g., "nodejs" matches "nodejs-direct" and "nodejs-build")
-		for target := range targetLangs {
-			if strings.HasPrefix(langName, target+"-") || strings.HasPrefix(langName, target+"_") {
-				return true
-			}
-			// Special case: "node" matches both "nodejs-*" and "nodets-*"
-			if target == "node" && (strings.HasPrefix(langName, "nodejs-") || strings.HasPrefix(langName, "nodets-")) {
-				return true
+		if outputFormat == outputNDJSON {
+			if err := writeJSON(e); err != nil {
+				fmt.Fprintf(os.Stderr, "WARNING: failed to write event: %v\n", err)
 			}
 		}
-		return false
 	}
 
-	var langsToRun []helloworldLang
-	for _, lang := range languages {
-		// If no targets specified, run all; otherwise check if lang matches any target
-		if matchesTarget(lang.name) {
-			// Skip interpreted languages only for compile mode
-			if benchMode == "compile" && lang.compileCmd == "" {
-				fmt.Printf("Skipping %s (interpreted, no compilation)\n", lang.name)
-				continue
+	res, err := bench.Run(ctx, cfg)
+	if outputFormat != outputTable && (summary == nil || outputFormat == outputJSON) {
+		// The run fails before it starts if no variant matches
+		if summary == nil {
+			summary = &bench.Event{Kind: bench.EventSummary, Time: time.Now(), Suite: cfg.Suite, Results: res}
+			if err != nil {
+				summary.Error = err.Error()
 			}
-			langsToRun = append(langsToRun, lang)
+		}
+		if writeErr := writeJSON(summary); writeErr != nil && err == nil {
+			err = writeErr
 		}
 	}
-
-	if len(langsToRun) == 0 {
-		return fmt.Errorf("no matching language found: %v", args)
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("interrupted")
 	}
+	return err
+}
 
-	fmt.Printf("Running %s benchmarks [mode: %s]\n", suiteName, benchMode)
-	fmt.Println(strings.Repeat("=", 80))
-
-	// For exec mode, pre-compile all binaries first
-	if benchMode == "exec" {
-		fmt.Println("Pre-compiling binaries...")
-		for _, lang := range langsToRun {
-			if lang.compileCmd == "" {
-				fmt.Printf("%-20s: interpreted (no build needed)\n", lang.name)
-				continue
-			}
-			fmt.Printf("%-20s: compiling... ", lang.name)
-			compileExec := exec.Command("sh", "-c", lang.compileCmd)
-			compileExec.Dir = lang.dir
-			output, err := compileExec.CombinedOutput()
-			if err != nil {
-				fmt.Printf("FAILED\n%s\n", string(output))
-				return fmt.Errorf("failed to compile %s: %w", lang.name, err)
-			}
-			fmt.Println("OK")
-		}
-		fmt.Println(strings.Repeat("=", 80))
+func buildBinary(cmd *cobra.Command, args []string) error {
+	b := builder.New(baseDir, logOutput())
+	if err := b.Build(); err != nil {
+		return err
 	}
+	if outputFormat != outputTable {
+		return writeJSON(map[string]string{"binary": b.GetBinaryPath()})
+	}
+	return nil
+}
 
-	fmt.Printf("\nRunning benchmarks with %s...\n", benchTool)
-	fmt.Println(strings.Repeat("=", 80))
-
-	// Build command args based on tool
-	var cmdArgs []string
-	if benchTool == "poop" {
-		for _, lang := range langsToRun {
-			var benchCmd string
-			switch benchMode {
-			case "compile":
-				benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.compileCmd)
-			case "full-cold":
-				if lang.compileCmd == "" {
-					// Interpreted language - just run
-					benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.runCmd)
-				} else {
-					benchCmd = fmt.Sprintf("cd %s && %s && %s && %s", lang.dir, lang.cleanCmd, lang.compileCmd, lang.runCmd)
-				}
-			case "full-hot":
-				if lang.compileCmd == "" {
-					// Interpreted language - just run
-					benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.runCmd)
-				} else if lang.fullHotCmd != "" {
-					// Use dedicated full-hot command (e.g., go run)
-					benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.fullHotCmd)
-				} else {
-					benchCmd = fmt.Sprintf("cd %s && %s && %s", lang.dir, lang.compileCmd, lang.runCmd)
-				}
-			case "exec":
-				benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.runCmd)
-			}
-			cmdArgs = append(cmdArgs, benchCmd)
-		}
-	} else {
-		cmdArgs = append(cmdArgs, "--warmup", fmt.Sprintf("%d", warmup), "--runs", fmt.Sprintf("%d", runs))
-
-		// Collect all benchmark commands with their prepare commands
-		type benchEntry struct {
-			name       string
-			dir        string
-			benchCmd   string
-			prepareCmd string
-		}
-		var entries []benchEntry
-
-		for _, lang := range langsToRun {
-			var benchCmd, prepareCmd string
-
-			switch benchMode {
-			case "compile":
-				benchCmd = lang.compileCmd
-				prepareCmd = lang.cleanCmd
-
-			case "full-cold":
-				if lang.compileCmd == "" {
-					// Interpreted language - just run
-					benchCmd = lang.runCmd
-				} else {
-					benchCmd = fmt.Sprintf("%s && %s", lang.compileCmd, lang.runCmd)
-					prepareCmd = lang.cleanCmd
-				}
-
-			case "full-hot":
-				if lang.compileCmd == "" {
-					// Interpreted language - just run
-					benchCmd = lang.runCmd
-				} else if lang.fullHotCmd != "" {
-					// Use dedicated full-hot command (e.g., go run)
-					benchCmd = lang.fullHotCmd
-				} else {
-					benchCmd = fmt.Sprintf("%s && %s", lang.compileCmd, lang.runCmd)
-				}
-
-			case "exec":
-				benchCmd = lang.runCmd
-			}
+// serverInfo describes a server in the output of list
+type serverInfo struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
 
-			entries = append(entries, benchEntry{name: lang.name, dir: lang.dir, benchCmd: benchCmd, prepareCmd: prepareCmd})
-		}
+// listTags is list --tags
+var listTags bool
 
-		// Check if any entry needs a prepare command
-		needsPrepare := false
-		for _, e := range entries {
-			if e.prepareCmd != "" {
-				needsPrepare = true
-				break
-			}
-		}
+func list(cmd *cobra.Command, args []string) error {
+	servers := config.GetServers(baseDir)
+	suites := bench.Suites(baseDir)
 
-		// Add commands with prepare if needed (hyperfine requires 0, 1, or N prepare commands)
-		for _, e := range entries {
-			if needsPrepare {
-				prepare := e.prepareCmd
-				if prepare == "" {
-					prepare = "true" // no-op for languages that don't need preparation
-				} else {
-					// Wrap prepare with cd to the directory
-					prepare = fmt.Sprintf("cd %s && %s", e.dir, prepare)
-				}
-				cmdArgs = append(cmdArgs, "--prepare", prepare)
-			}
-			// Wrap benchmark command with cd to the directory, show just name: cmd in display
-			fullCmd := fmt.Sprintf("cd %s && %s", e.dir, e.benchCmd)
-			cmdArgs = append(cmdArgs, "--command-name", fmt.Sprintf("%s: %s", e.name, e.benchCmd), fullCmd)
+	if outputFormat != outputTable {
+		infos := make([]serverInfo, 0, len(servers))
+		for _, s := range servers {
+			infos = append(infos, serverInfo{Name: s.Name, Port: s.Port})
 		}
+		return writeJSON(struct {
+			Servers  []serverInfo      `json:"servers"`
+			Suites   []bench.SuiteInfo `json:"suites"`
+			Profiles []string          `json:"profiles"`
+		}{infos, suites, profileNames()})
 	}
 
-	// Run benchmark tool
-	benchExec := exec.Command(benchTool, cmdArgs...)
-	benchExec.Stdout = os.Stdout
-	benchExec.Stderr = os.Stderr
-	benchExec.Dir = baseDir
-
-	if err := benchExec.Run(); err != nil {
-		return fmt.Errorf("%s failed: %w", benchTool, err)
+	fmt.Println("Available servers:")
+	for _, s := range servers {
+		fmt.Printf("  - %s\n", s.Name)
 	}
-
-	fmt.Println(strings.Repeat("=", 80))
-
-	// Report binary sizes for compile modes
-	if benchMode == "compile" || benchMode == "full-cold" || benchMode == "full-hot" {
-		fmt.Println("\nBinary sizes:")
-		fmt.Println(strings.Repeat("-", 40))
-		fmt.Printf("%-20s %10s\n", "Language", "Size")
-		fmt.Println(strings.Repeat("-", 40))
-
-		for _, lang := range langsToRun {
-			if lang.binaryPath == "" {
-				continue
-			}
-			binaryFullPath := filepath.Join(lang.dir, lang.binaryPath)
-			if info, err := os.Stat(binaryFullPath); err == nil {
-				size := info.Size()
-				var sizeStr string
-				if size >= 1024*1024 {
-					sizeStr = fmt.Sprintf("%.2f MB", float64(size)/(1024*1024))
-				} else if size >= 1024 {
-					sizeStr = fmt.Sprintf("%.2f KB", float64(size)/1024)
-				} else {
-					sizeStr = fmt.Sprintf("%d B", size)
-				}
-				fmt.Printf("%-20s %10s\n", lang.name, sizeStr)
-			} else {
-				fmt.Printf("%-20s %10s\n", lang.name, "N/A")
-			}
+	fmt.Println("\nAvailable suites:")
+	for _, s := range suites {
+		if !listTags {
+			fmt.Printf("  - %-14s %s\n", s.Name, strings.Join(s.Variants, ", "))
+			continue
+		}
+		fmt.Printf("  - %s\n", s.Name)
+		for _, v := range s.Variants {
+			fmt.Printf("      %-18s %s\n", v, bench.FormatTags(s.Tags[v]))
 		}
-		fmt.Println(strings.Repeat("-", 40))
 	}
-
-	// Clean up build artifacts
-	fmt.Println("\nCleaning up build artifacts...")
-	for _, lang := range langsToRun {
-		if lang.cleanCmd != "" {
-			cleanExec := exec.Command("sh", "-c", lang.cleanCmd)
-			cleanExec.Dir = lang.dir
-			cleanExec.Run()
+	if project != nil {
+		fmt.Printf("\nProfiles (%s):\n", project.Path)
+		for _, name := range profileNames() {
+			fmt.Printf("  - %s\n", name)
 		}
 	}
-
 	return nil
 }
+
+// profileNames returns the profiles of the configuration file, if any
+func profileNames() []string {
+	if project == nil {
+		return []string{}
+	}
+	return project.ProfileNames()
+}
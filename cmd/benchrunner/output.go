@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// Output formats of the --output flag
+const (
+	outputTable  = "table"
+	outputJSON   = "json"
+	outputNDJSON = "ndjson"
+)
+
+var outputFormat string
+
+// setOutput validates --output
+func setOutput(cmd *cobra.Command, args []string) error {
+	switch outputFormat {
+	case outputTable, outputJSON, outputNDJSON:
+		return nil
+	}
+	return fmt.Errorf("invalid output format: %s (valid: table, json, ndjson)", outputFormat)
+}
+
+// logOutput returns where the human logs of the runner and the programs it
+// starts go: stdout for tables, stderr for the machine-readable formats so
+// that stdout only holds the JSON
+func logOutput() io.Writer {
+	if outputFormat == outputTable {
+		return os.Stdout
+	}
+	return os.Stderr
+}
+
+// writeJSON writes v to stdout: indented for json, on one line for ndjson
+func writeJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	if outputFormat == outputJSON {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(v)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/benchmarks/pkg/bench"
+)
+
+// printPlan prints the plan of --dry-run: as one JSON document in the json
+// and ndjson formats, otherwise as text
+func printPlan(plan *bench.Plan) error {
+	if outputFormat != outputTable {
+		return writeJSON(plan)
+	}
+
+	if plan.Skipped != "" {
+		fmt.Printf("Dry run of %s: skipped, %s\n", plan.Suite, plan.Skipped)
+		return nil
+	}
+	fmt.Printf("Dry run of %s: nothing is executed\n", plan.Suite)
+	for _, b := range plan.Benchmarks {
+		fmt.Println("\n" + strings.Repeat("=", 80))
+		order := ""
+		if b.Order != "" {
+			order = ", order " + b.Order
+		}
+		fmt.Printf("%s%s (mode %s, tool %s%s)\n", b.Name, formatParams(b.Params), b.Mode, b.Tool, order)
+		fmt.Println(strings.Repeat("=", 80))
+		for _, input := range b.Inputs {
+			fmt.Printf("Input: %s\n", input)
+		}
+		for _, v := range b.Variants {
+			fmt.Printf("\n%s\n", v.Name)
+			if v.Source != "" {
+				fmt.Printf("  copy:    %s -> %s\n", v.Source, v.Dir)
+			} else {
+				fmt.Printf("  dir:     %s\n", v.Dir)
+			}
+			for _, env := range v.Env {
+				fmt.Printf("  env:     %s\n", env)
+			}
+			for _, step := range v.Steps {
+				fmt.Printf("  %-8s %s%s\n", step.Phase+":", step.Action, stepLimits(step))
+				if step.Command != "" {
+					fmt.Printf("           $ %s\n", step.Command)
+				}
+			}
+		}
+		if len(b.ToolCommand) > 0 {
+			fmt.Printf("\n%s invocation:\n  %s\n", b.Tool, shellJoin(b.ToolCommand))
+		}
+		if len(b.Skipped) > 0 {
+			fmt.Println("\nSkipped:")
+			for _, skip := range b.Skipped {
+				fmt.Printf("  %-20s %s\n", skip.Name, skip.Reason)
+			}
+		}
+	}
+	return nil
+}
+
+// formatParams formats benchmark parameters as " [name=value ...]"
+func formatParams(params map[string]string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"="+params[name])
+	}
+	return " [" + strings.Join(pairs, " ") + "]"
+}
+
+// stepLimits formats the repeat count and timeout of a step
+func stepLimits(step bench.PlanStep) string {
+	var limits []string
+	if step.Repeat > 1 {
+		limits = append(limits, fmt.Sprintf("x%d", step.Repeat))
+	}
+	if step.Timeout != "" {
+		limits = append(limits, "timeout "+step.Timeout)
+	}
+	if len(limits) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(limits, ", ") + ")"
+}
+
+// shellJoin joins argv into a command line, quoting the arguments a shell
+// would split or expand
+func shellJoin(argv []string) string {
+	quoted := make([]string, len(argv))
+	for i, arg := range argv {
+		if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_=+./:,@%") == "" {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
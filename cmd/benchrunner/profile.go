@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/benchmarks/internal/config"
+	"github.com/benchmarks/pkg/bench"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	configPath  string // --config; default: benchrunner.yaml at the repository root
+	profileName string // --profile
+	resultsDir  string // --results-dir, relative to the repository root
+
+	// project is the configuration file, if there is one, and profile the
+	// values it sets for this invocation
+	project *config.Project
+	profile config.Profile
+)
+
+// applyProfile sets the flags of cmd that are not on the command line from
+// the defaults of the configuration file and the selected profile
+func applyProfile(cmd *cobra.Command) error {
+	path := configPath
+	if path == "" {
+		path = config.ProjectPath(baseDir)
+	}
+	var err error
+	project, err = config.LoadProject(path)
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		if configPath != "" || profileName != "" {
+			return fmt.Errorf("no configuration file at %s", path)
+		}
+		return nil
+	}
+	if profile, err = project.Resolve(profileName); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for name := range profile.Flags {
+		if name == "config" || name == "profile" || !knownFlag(cmd.Root(), name) {
+			return fmt.Errorf("%s: unknown setting %q", path, name)
+		}
+	}
+	for suiteName, flags := range profile.Suites {
+		for flag, value := range flags {
+			if err := bench.CheckSetting(baseDir, suiteName, flag, value); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+
+	if err := setFlags(cmd.Flags(), profile.Flags); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if isSuiteCommand(cmd) {
+		if err := setFlags(cmd.Flags(), profile.Suites[cmd.Name()]); err != nil {
+			return fmt.Errorf("%s: %s: %w", path, cmd.Name(), err)
+		}
+	}
+	return nil
+}
+
+// setFlags sets the flags of fs that values has and the command line
+// doesn't. Values for flags fs doesn't have are for other commands.
+func setFlags(fs *pflag.FlagSet, values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := fs.Lookup(name)
+		if f == nil || f.Changed {
+			continue
+		}
+		if err := fs.Set(name, values[name]); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// profileSettings adds the suite flags of the profile to the settings of
+// run all, for the suites it runs, unless --set gave them
+func profileSettings(cfg *bench.AllConfig) {
+	for suiteName, flags := range profile.Suites {
+		if len(cfg.Suites) > 0 && !contains(cfg.Suites, suiteName) {
+			continue
+		}
+		for flag, value := range flags {
+			if _, set := cfg.Settings[suiteName][flag]; set {
+				continue
+			}
+			if cfg.Settings == nil {
+				cfg.Settings = make(map[string]map[string]string)
+			}
+			if cfg.Settings[suiteName] == nil {
+				cfg.Settings[suiteName] = make(map[string]string)
+			}
+			cfg.Settings[suiteName][flag] = value
+		}
+	}
+}
+
+// resultsPath returns where results are saved: --results-dir, relative to
+// the repository root
+func resultsPath() string {
+	if filepath.IsAbs(resultsDir) {
+		return resultsDir
+	}
+	return filepath.Join(baseDir, resultsDir)
+}
+
+// isSuiteCommand reports whether cmd is the run subcommand of a suite
+func isSuiteCommand(cmd *cobra.Command) bool {
+	return cmd.HasParent() && cmd.Parent().Name() == "run" && cmd.Name() != "all"
+}
+
+// knownFlag reports whether cmd or one of its subcommands has the flag
+func knownFlag(cmd *cobra.Command, name string) bool {
+	if cmd.Flags().Lookup(name) != nil || cmd.PersistentFlags().Lookup(name) != nil {
+		return true
+	}
+	for _, sub := range cmd.Commands() {
+		if knownFlag(sub, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
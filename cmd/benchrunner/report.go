@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/benchmarks/pkg/bench"
+)
+
+// printReport prints the report of run all: a section per suite with the
+// outcome of its variants, then the failures and skips of the whole run
+func printReport(report *bench.Report) {
+	fmt.Println("\n" + strings.Repeat("=", 80))
+	fmt.Printf("REPORT (%d suites, %.0fs)\n", len(report.Suites), report.Duration)
+	fmt.Println(strings.Repeat("=", 80))
+
+	for _, s := range report.Suites {
+		fmt.Printf("\n%s: %s (%.0fs)\n", s.Suite, s.Status, s.Duration)
+		if s.Error != "" {
+			fmt.Printf("  %s\n", s.Error)
+		}
+		for _, res := range s.Results {
+			fmt.Printf("  %s%s\n", res.Suite, formatParams(res.Params))
+			for _, v := range res.Variants {
+				fmt.Printf("    %-20s %-12s %s\n", v.Name, v.Status, headline(v))
+			}
+		}
+	}
+
+	sum := report.Summary
+	fmt.Println("\n" + strings.Repeat("-", 80))
+	fmt.Printf("Suites:   %s\n", formatCounts(sum.Suites))
+	fmt.Printf("Variants: %s\n", formatCounts(sum.Variants))
+	if len(sum.Failures) > 0 {
+		fmt.Println("\nFailures:")
+		for _, f := range sum.Failures {
+			fmt.Printf("  %-40s %-12s %s\n", failureName(f), f.Status, firstLine(f.Error))
+		}
+	}
+	if len(sum.Skipped) > 0 {
+		fmt.Println("\nSkipped:")
+		for _, f := range sum.Skipped {
+			fmt.Printf("  %-40s %s\n", failureName(f), f.Error)
+		}
+	}
+	fmt.Println(strings.Repeat("=", 80))
+}
+
+// headline is the main measurement of a variant: its total time, or the
+// first metric it has
+func headline(v bench.Variant) string {
+	switch {
+	case v.Status != bench.StatusOK && v.Error != "":
+		return firstLine(v.Error)
+	case v.Total != nil:
+		return fmt.Sprintf("total %.2f ± %.2f ms", v.Total.Mean, v.Total.StdDev)
+	case v.Run != nil:
+		return fmt.Sprintf("run %.2f ± %.2f ms", v.Run.Mean, v.Run.StdDev)
+	case len(v.Metrics) > 0:
+		m := v.Metrics[0]
+		return fmt.Sprintf("%s %.2f ± %.2f %s", m.Name, m.Mean, m.StdDev, m.Unit)
+	}
+	return ""
+}
+
+// formatCounts formats counts by status as "3 OK, 1 FAILED", sorted by
+// status
+func formatCounts(counts map[string]int) string {
+	if len(counts) == 0 {
+		return "none"
+	}
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	parts := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// failureName names the suite, benchmark and variant of a failure
+func failureName(f bench.Failure) string {
+	name := f.Suite
+	if f.Benchmark != "" && f.Benchmark != f.Suite {
+		name = f.Benchmark
+	}
+	if f.Variant != "" {
+		name += " " + f.Variant
+	}
+	return name
+}
+
+// firstLine returns the first line of a possibly multi-line error
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
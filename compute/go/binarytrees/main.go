@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSize = 16
+	minDepth    = 4
+)
+
+type node struct {
+	left, right *node
+}
+
+func bottomUpTree(depth int) *node {
+	if depth <= 0 {
+		return &node{}
+	}
+	return &node{bottomUpTree(depth - 1), bottomUpTree(depth - 1)}
+}
+
+func (n *node) check() int {
+	if n.left == nil {
+		return 1
+	}
+	return 1 + n.left.check() + n.right.check()
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	maxDepth := param(1, "BENCH_SIZE", defaultSize)
+
+	start := time.Now()
+	if maxDepth < minDepth+2 {
+		maxDepth = minDepth + 2
+	}
+
+	stretchDepth := maxDepth + 1
+	fmt.Printf("stretch tree of depth %d\t check: %d\n", stretchDepth, bottomUpTree(stretchDepth).check())
+
+	longLived := bottomUpTree(maxDepth)
+
+	for depth := minDepth; depth <= maxDepth; depth += 2 {
+		iterations := 1 << uint(maxDepth-depth+minDepth)
+		check := 0
+		for i := 0; i < iterations; i++ {
+			check += bottomUpTree(depth).check()
+		}
+		fmt.Printf("%d\t trees of depth %d\t check: %d\n", iterations, depth, check)
+	}
+
+	fmt.Printf("long lived tree of depth %d\t check: %d\n", maxDepth, longLived.check())
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
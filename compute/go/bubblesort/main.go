@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSize = 10000
+	defaultSeed = 42
+)
+
+func bubbleSort(arr []int) []int {
+	n := len(arr)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n-i-1; j++ {
+			if arr[j] > arr[j+1] {
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+			}
+		}
+	}
+	return arr
+}
+
+// generate returns size pseudo-random values in [0, 10000) using xorshift32,
+// matching the other language implementations
+func generate(size int, seed uint32) []int {
+	state := seed
+	if state == 0 {
+		state = 1
+	}
+	arr := make([]int, size)
+	for i := range arr {
+		state ^= state << 13
+		state ^= state >> 17
+		state ^= state << 5
+		arr[i] = int(state % 10000)
+	}
+	return arr
+}
+
+func checksum(arr []int) int {
+	sum := 0
+	for i, v := range arr {
+		sum = (sum + (i+1)*v) % 1000000007
+	}
+	return sum
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	size := param(1, "BENCH_SIZE", defaultSize)
+	seed := param(2, "BENCH_SEED", defaultSeed)
+
+	start := time.Now()
+
+	arr := bubbleSort(generate(size, uint32(seed)))
+	fmt.Printf("size=%d seed=%d checksum=%d\n", size, seed, checksum(arr))
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
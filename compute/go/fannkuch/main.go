@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const defaultSize = 10
+
+// fannkuch returns the checksum and the maximum number of flips over all
+// permutations of n elements, visited in the Benchmarks Game order
+func fannkuch(n int) (int, int) {
+	perm := make([]int, n)
+	perm1 := make([]int, n)
+	count := make([]int, n)
+	for i := range perm1 {
+		perm1[i] = i
+	}
+
+	checksum, maxFlips, permCount := 0, 0, 0
+	r := n
+	for {
+		for ; r != 1; r-- {
+			count[r-1] = r
+		}
+
+		copy(perm, perm1)
+		flips := 0
+		for k := perm[0]; k != 0; k = perm[0] {
+			for i, j := 0, k; i < j; i, j = i+1, j-1 {
+				perm[i], perm[j] = perm[j], perm[i]
+			}
+			flips++
+		}
+		if flips > maxFlips {
+			maxFlips = flips
+		}
+		if permCount%2 == 0 {
+			checksum += flips
+		} else {
+			checksum -= flips
+		}
+
+		// Advance to the next permutation
+		for {
+			if r == n {
+				return checksum, maxFlips
+			}
+			perm0 := perm1[0]
+			for i := 0; i < r; i++ {
+				perm1[i] = perm1[i+1]
+			}
+			perm1[r] = perm0
+			count[r]--
+			if count[r] > 0 {
+				break
+			}
+			r++
+		}
+		permCount++
+	}
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	n := param(1, "BENCH_SIZE", defaultSize)
+
+	start := time.Now()
+
+	checksum, maxFlips := fannkuch(n)
+	fmt.Printf("%d\nPfannkuchen(%d) = %d\n", checksum, n, maxFlips)
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
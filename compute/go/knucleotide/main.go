@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSize = 1000000
+	defaultSeed = 42
+
+	// Linear congruential generator used by the Benchmarks Game fasta program
+	im = 139968
+	ia = 3877
+	ic = 29573
+)
+
+// thresholds split [0, im) into a, c, g and t with the fasta homo sapiens
+// frequencies, as integers so every language picks the same nucleotides
+var thresholds = [3]uint32{42404, 70116, 97766}
+
+// generate returns a DNA sequence of the given length
+func generate(size int, seed uint32) []byte {
+	const nucleotides = "ACGT"
+	last := seed % im
+	seq := make([]byte, size)
+	for i := range seq {
+		last = (last*ia + ic) % im
+		k := 3
+		for t, limit := range thresholds {
+			if last < limit {
+				k = t
+				break
+			}
+		}
+		seq[i] = nucleotides[k]
+	}
+	return seq
+}
+
+// frequencies counts every substring of length k
+func frequencies(seq []byte, k int) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i+k <= len(seq); i++ {
+		counts[string(seq[i:i+k])]++
+	}
+	return counts
+}
+
+// sortedFrequencies formats the k-mer frequencies in percent, most frequent
+// first and ties broken alphabetically
+func sortedFrequencies(seq []byte, k int) string {
+	counts := frequencies(seq, k)
+	keys := make([]string, 0, len(counts))
+	total := 0
+	for key, n := range counts {
+		keys = append(keys, key)
+		total += n
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s %.3f\n", key, 100*float64(counts[key])/float64(total))
+	}
+	return b.String()
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	size := param(1, "BENCH_SIZE", defaultSize)
+	seed := param(2, "BENCH_SEED", defaultSeed)
+
+	start := time.Now()
+
+	seq := generate(size, uint32(seed))
+
+	fmt.Println(sortedFrequencies(seq, 1))
+	fmt.Println(sortedFrequencies(seq, 2))
+	for _, fragment := range []string{"GGT", "GGTA", "GGTATT", "GGTATTTTAATT", "GGTATTTTAATTTATAGT"} {
+		fmt.Printf("%d\t%s\n", frequencies(seq, len(fragment))[fragment], fragment)
+	}
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSize = 1000000
+
+	solarMass   = 4 * math.Pi * math.Pi
+	daysPerYear = 365.24
+)
+
+type body struct {
+	x, y, z, vx, vy, vz, mass float64
+}
+
+func newSystem() []body {
+	bodies := []body{
+		// Sun
+		{mass: solarMass},
+		// Jupiter
+		{
+			x: 4.84143144246472090e+00, y: -1.16032004402742839e+00, z: -1.03622044471123109e-01,
+			vx: 1.66007664274403694e-03 * daysPerYear, vy: 7.69901118419740425e-03 * daysPerYear, vz: -6.90460016972063023e-05 * daysPerYear,
+			mass: 9.54791938424326609e-04 * solarMass,
+		},
+		// Saturn
+		{
+			x: 8.34336671824457987e+00, y: 4.12479856412430479e+00, z: -4.03523417114321381e-01,
+			vx: -2.76742510726862411e-03 * daysPerYear, vy: 4.99852801234917238e-03 * daysPerYear, vz: 2.30417297573763929e-05 * daysPerYear,
+			mass: 2.85885980666130812e-04 * solarMass,
+		},
+		// Uranus
+		{
+			x: 1.28943695621391310e+01, y: -1.51111514016986312e+01, z: -2.23307578892655734e-01,
+			vx: 2.96460137564761618e-03 * daysPerYear, vy: 2.37847173959480950e-03 * daysPerYear, vz: -2.96589568540237556e-05 * daysPerYear,
+			mass: 4.36624404335156298e-05 * solarMass,
+		},
+		// Neptune
+		{
+			x: 1.53796971148509165e+01, y: -2.59193146099879641e+01, z: 1.79258772950371181e-01,
+			vx: 2.68067772490389322e-03 * daysPerYear, vy: 1.62824170038242295e-03 * daysPerYear, vz: -9.51592254519715870e-05 * daysPerYear,
+			mass: 5.15138902046611451e-05 * solarMass,
+		},
+	}
+
+	// Offset the sun's momentum so the system's total momentum is zero
+	var px, py, pz float64
+	for _, b := range bodies {
+		px += b.vx * b.mass
+		py += b.vy * b.mass
+		pz += b.vz * b.mass
+	}
+	bodies[0].vx = -px / solarMass
+	bodies[0].vy = -py / solarMass
+	bodies[0].vz = -pz / solarMass
+	return bodies
+}
+
+func advance(bodies []body, dt float64) {
+	for i := range bodies {
+		bi := &bodies[i]
+		for j := i + 1; j < len(bodies); j++ {
+			bj := &bodies[j]
+			dx := bi.x - bj.x
+			dy := bi.y - bj.y
+			dz := bi.z - bj.z
+			dSquared := dx*dx + dy*dy + dz*dz
+			distance := math.Sqrt(dSquared)
+			mag := dt / (dSquared * distance)
+			bi.vx -= dx * bj.mass * mag
+			bi.vy -= dy * bj.mass * mag
+			bi.vz -= dz * bj.mass * mag
+			bj.vx += dx * bi.mass * mag
+			bj.vy += dy * bi.mass * mag
+			bj.vz += dz * bi.mass * mag
+		}
+	}
+	for i := range bodies {
+		b := &bodies[i]
+		b.x += dt * b.vx
+		b.y += dt * b.vy
+		b.z += dt * b.vz
+	}
+}
+
+func energy(bodies []body) float64 {
+	e := 0.0
+	for i, bi := range bodies {
+		e += 0.5 * bi.mass * (bi.vx*bi.vx + bi.vy*bi.vy + bi.vz*bi.vz)
+		for j := i + 1; j < len(bodies); j++ {
+			bj := bodies[j]
+			dx := bi.x - bj.x
+			dy := bi.y - bj.y
+			dz := bi.z - bj.z
+			e -= bi.mass * bj.mass / math.Sqrt(dx*dx+dy*dy+dz*dz)
+		}
+	}
+	return e
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	steps := param(1, "BENCH_SIZE", defaultSize)
+
+	start := time.Now()
+
+	bodies := newSystem()
+	fmt.Printf("%.9f\n", energy(bodies))
+	for i := 0; i < steps; i++ {
+		advance(bodies, 0.01)
+	}
+	fmt.Printf("%.9f\n", energy(bodies))
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+const defaultSize = 1000
+
+// a returns entry (i, j) of the infinite matrix A
+func a(i, j int) float64 {
+	return 1.0 / float64((i+j)*(i+j+1)/2+i+1)
+}
+
+func multiplyAv(v, av []float64) {
+	for i := range av {
+		sum := 0.0
+		for j := range v {
+			sum += a(i, j) * v[j]
+		}
+		av[i] = sum
+	}
+}
+
+func multiplyAtv(v, atv []float64) {
+	for i := range atv {
+		sum := 0.0
+		for j := range v {
+			sum += a(j, i) * v[j]
+		}
+		atv[i] = sum
+	}
+}
+
+func multiplyAtAv(v, out, tmp []float64) {
+	multiplyAv(v, tmp)
+	multiplyAtv(tmp, out)
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	n := param(1, "BENCH_SIZE", defaultSize)
+
+	start := time.Now()
+
+	u := make([]float64, n)
+	v := make([]float64, n)
+	tmp := make([]float64, n)
+	for i := range u {
+		u[i] = 1
+	}
+	for i := 0; i < 10; i++ {
+		multiplyAtAv(u, v, tmp)
+		multiplyAtAv(v, u, tmp)
+	}
+
+	var vBv, vv float64
+	for i := range v {
+		vBv += u[i] * v[i]
+		vv += v[i] * v[i]
+	}
+	fmt.Printf("%.9f\n", math.Sqrt(vBv/vv))
+	fmt.Printf("METRIC kernel_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
@@ -0,0 +1,57 @@
+#!/usr/bin/env node
+
+const DEFAULT_SIZE = 16;
+const MIN_DEPTH = 4;
+
+function bottomUpTree(depth) {
+    if (depth <= 0) {
+        return { left: null, right: null };
+    }
+    return { left: bottomUpTree(depth - 1), right: bottomUpTree(depth - 1) };
+}
+
+function check(node) {
+    if (node.left === null) {
+        return 1;
+    }
+    return 1 + check(node.left) + check(node.right);
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n)) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+function main() {
+    const maxDepth = Math.max(MIN_DEPTH + 2, param(1, "BENCH_SIZE", DEFAULT_SIZE));
+
+    const start = performance.now();
+
+    const stretchDepth = maxDepth + 1;
+    console.log(`stretch tree of depth ${stretchDepth}\t check: ${check(bottomUpTree(stretchDepth))}`);
+
+    const longLived = bottomUpTree(maxDepth);
+
+    for (let depth = MIN_DEPTH; depth <= maxDepth; depth += 2) {
+        const iterations = 2 ** (maxDepth - depth + MIN_DEPTH);
+        let total = 0;
+        for (let i = 0; i < iterations; i++) {
+            total += check(bottomUpTree(depth));
+        }
+        console.log(`${iterations}\t trees of depth ${depth}\t check: ${total}`);
+    }
+
+    console.log(`long lived tree of depth ${maxDepth}\t check: ${check(longLived)}`);
+    console.log(`METRIC kernel_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+main();
@@ -0,0 +1,79 @@
+#!/usr/bin/env node
+
+const DEFAULT_SIZE = 10;
+
+// Checksum and maximum number of flips over all permutations of n elements,
+// visited in the Benchmarks Game order
+function fannkuch(n) {
+    const perm = new Int32Array(n);
+    const perm1 = new Int32Array(n);
+    const count = new Int32Array(n);
+    for (let i = 0; i < n; i++) {
+        perm1[i] = i;
+    }
+
+    let checksum = 0, maxFlips = 0, permCount = 0;
+    let r = n;
+    for (;;) {
+        for (; r !== 1; r--) {
+            count[r - 1] = r;
+        }
+
+        perm.set(perm1);
+        let flips = 0;
+        for (let k = perm[0]; k !== 0; k = perm[0]) {
+            for (let i = 0, j = k; i < j; i++, j--) {
+                const t = perm[i];
+                perm[i] = perm[j];
+                perm[j] = t;
+            }
+            flips++;
+        }
+        maxFlips = Math.max(maxFlips, flips);
+        checksum += permCount % 2 === 0 ? flips : -flips;
+
+        // Advance to the next permutation
+        for (;;) {
+            if (r === n) {
+                return [checksum, maxFlips];
+            }
+            const perm0 = perm1[0];
+            for (let i = 0; i < r; i++) {
+                perm1[i] = perm1[i + 1];
+            }
+            perm1[r] = perm0;
+            count[r]--;
+            if (count[r] > 0) {
+                break;
+            }
+            r++;
+        }
+        permCount++;
+    }
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n)) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+function main() {
+    const n = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    const start = performance.now();
+
+    const [checksum, maxFlips] = fannkuch(n);
+    console.log(`${checksum}\nPfannkuchen(${n}) = ${maxFlips}`);
+    console.log(`METRIC kernel_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+main();
@@ -0,0 +1,88 @@
+#!/usr/bin/env node
+
+const DEFAULT_SIZE = 1000000;
+const DEFAULT_SEED = 42;
+
+// Linear congruential generator used by the Benchmarks Game fasta program
+const IM = 139968;
+const IA = 3877;
+const IC = 29573;
+
+// Splits [0, IM) into a, c, g and t with the fasta homo sapiens frequencies,
+// as integers so every language picks the same nucleotides
+const THRESHOLDS = [42404, 70116, 97766];
+
+// DNA sequence of the given length
+function generate(size, seed) {
+    const nucleotides = "ACGT";
+    let last = seed % IM;
+    const seq = new Array(size);
+    for (let i = 0; i < size; i++) {
+        last = (last * IA + IC) % IM;
+        let k = THRESHOLDS.findIndex((t) => last < t);
+        if (k === -1) {
+            k = 3;
+        }
+        seq[i] = nucleotides[k];
+    }
+    return seq.join("");
+}
+
+// Counts every substring of length k
+function frequencies(seq, k) {
+    const counts = new Map();
+    for (let i = 0; i + k <= seq.length; i++) {
+        const key = seq.substring(i, i + k);
+        counts.set(key, (counts.get(key) ?? 0) + 1);
+    }
+    return counts;
+}
+
+// K-mer frequencies in percent, most frequent first and ties broken
+// alphabetically
+function sortedFrequencies(seq, k) {
+    const counts = frequencies(seq, k);
+    let total = 0;
+    for (const n of counts.values()) {
+        total += n;
+    }
+    const entries = [...counts.entries()].sort((a, b) => b[1] - a[1] || (a[0] < b[0] ? -1 : 1));
+
+    let out = "";
+    for (const [key, n] of entries) {
+        out += `${key} ${((100 * n) / total).toFixed(3)}\n`;
+    }
+    return out;
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n)) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+function main() {
+    const size = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+    const seed = param(2, "BENCH_SEED", DEFAULT_SEED);
+
+    const start = performance.now();
+
+    const seq = generate(size, seed);
+
+    console.log(sortedFrequencies(seq, 1));
+    console.log(sortedFrequencies(seq, 2));
+    for (const fragment of ["GGT", "GGTA", "GGTATT", "GGTATTTTAATT", "GGTATTTTAATTTATAGT"]) {
+        console.log(`${frequencies(seq, fragment.length).get(fragment) ?? 0}\t${fragment}`);
+    }
+    console.log(`METRIC kernel_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+main();
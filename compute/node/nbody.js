@@ -0,0 +1,132 @@
+#!/usr/bin/env node
+
+const DEFAULT_SIZE = 1000000;
+
+const SOLAR_MASS = 4 * Math.PI * Math.PI;
+const DAYS_PER_YEAR = 365.24;
+
+function body(x, y, z, vx, vy, vz, mass) {
+    return {
+        x, y, z,
+        vx: vx * DAYS_PER_YEAR,
+        vy: vy * DAYS_PER_YEAR,
+        vz: vz * DAYS_PER_YEAR,
+        mass: mass * SOLAR_MASS,
+    };
+}
+
+function newSystem() {
+    const bodies = [
+        // Sun
+        { x: 0, y: 0, z: 0, vx: 0, vy: 0, vz: 0, mass: SOLAR_MASS },
+        // Jupiter
+        body(
+            4.84143144246472090e+00, -1.16032004402742839e+00, -1.03622044471123109e-01,
+            1.66007664274403694e-03, 7.69901118419740425e-03, -6.90460016972063023e-05,
+            9.54791938424326609e-04,
+        ),
+        // Saturn
+        body(
+            8.34336671824457987e+00, 4.12479856412430479e+00, -4.03523417114321381e-01,
+            -2.76742510726862411e-03, 4.99852801234917238e-03, 2.30417297573763929e-05,
+            2.85885980666130812e-04,
+        ),
+        // Uranus
+        body(
+            1.28943695621391310e+01, -1.51111514016986312e+01, -2.23307578892655734e-01,
+            2.96460137564761618e-03, 2.37847173959480950e-03, -2.96589568540237556e-05,
+            4.36624404335156298e-05,
+        ),
+        // Neptune
+        body(
+            1.53796971148509165e+01, -2.59193146099879641e+01, 1.79258772950371181e-01,
+            2.68067772490389322e-03, 1.62824170038242295e-03, -9.51592254519715870e-05,
+            5.15138902046611451e-05,
+        ),
+    ];
+
+    // Offset the sun's momentum so the system's total momentum is zero
+    let px = 0, py = 0, pz = 0;
+    for (const b of bodies) {
+        px += b.vx * b.mass;
+        py += b.vy * b.mass;
+        pz += b.vz * b.mass;
+    }
+    bodies[0].vx = -px / SOLAR_MASS;
+    bodies[0].vy = -py / SOLAR_MASS;
+    bodies[0].vz = -pz / SOLAR_MASS;
+    return bodies;
+}
+
+function advance(bodies, dt) {
+    const n = bodies.length;
+    for (let i = 0; i < n; i++) {
+        const bi = bodies[i];
+        for (let j = i + 1; j < n; j++) {
+            const bj = bodies[j];
+            const dx = bi.x - bj.x;
+            const dy = bi.y - bj.y;
+            const dz = bi.z - bj.z;
+            const dSquared = dx * dx + dy * dy + dz * dz;
+            const distance = Math.sqrt(dSquared);
+            const mag = dt / (dSquared * distance);
+            bi.vx -= dx * bj.mass * mag;
+            bi.vy -= dy * bj.mass * mag;
+            bi.vz -= dz * bj.mass * mag;
+            bj.vx += dx * bi.mass * mag;
+            bj.vy += dy * bi.mass * mag;
+            bj.vz += dz * bi.mass * mag;
+        }
+    }
+    for (const b of bodies) {
+        b.x += dt * b.vx;
+        b.y += dt * b.vy;
+        b.z += dt * b.vz;
+    }
+}
+
+function energy(bodies) {
+    let e = 0;
+    for (let i = 0; i < bodies.length; i++) {
+        const bi = bodies[i];
+        e += 0.5 * bi.mass * (bi.vx * bi.vx + bi.vy * bi.vy + bi.vz * bi.vz);
+        for (let j = i + 1; j < bodies.length; j++) {
+            const bj = bodies[j];
+            const dx = bi.x - bj.x;
+            const dy = bi.y - bj.y;
+            const dz = bi.z - bj.z;
+            e -= bi.mass * bj.mass / Math.sqrt(dx * dx + dy * dy + dz * dz);
+        }
+    }
+    return e;
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n)) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+function main() {
+    const steps = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    const start = performance.now();
+
+    const bodies = newSystem();
+    console.log(energy(bodies).toFixed(9));
+    for (let i = 0; i < steps; i++) {
+        advance(bodies, 0.01);
+    }
+    console.log(energy(bodies).toFixed(9));
+    console.log(`METRIC kernel_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+main();
@@ -0,0 +1,71 @@
+#!/usr/bin/env node
+
+const DEFAULT_SIZE = 1000;
+
+// Entry (i, j) of the infinite matrix A
+function a(i, j) {
+    return 1 / (((i + j) * (i + j + 1)) / 2 + i + 1);
+}
+
+function multiplyAv(v, av) {
+    for (let i = 0; i < av.length; i++) {
+        let sum = 0;
+        for (let j = 0; j < v.length; j++) {
+            sum += a(i, j) * v[j];
+        }
+        av[i] = sum;
+    }
+}
+
+function multiplyAtv(v, atv) {
+    for (let i = 0; i < atv.length; i++) {
+        let sum = 0;
+        for (let j = 0; j < v.length; j++) {
+            sum += a(j, i) * v[j];
+        }
+        atv[i] = sum;
+    }
+}
+
+function multiplyAtAv(v, out, tmp) {
+    multiplyAv(v, tmp);
+    multiplyAtv(tmp, out);
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n)) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+function main() {
+    const n = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    const start = performance.now();
+
+    const u = new Float64Array(n).fill(1);
+    const v = new Float64Array(n);
+    const tmp = new Float64Array(n);
+    for (let i = 0; i < 10; i++) {
+        multiplyAtAv(u, v, tmp);
+        multiplyAtAv(v, u, tmp);
+    }
+
+    let vBv = 0, vv = 0;
+    for (let i = 0; i < n; i++) {
+        vBv += u[i] * v[i];
+        vv += v[i] * v[i];
+    }
+    console.log(Math.sqrt(vBv / vv).toFixed(9));
+    console.log(`METRIC kernel_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+main();
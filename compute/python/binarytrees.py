@@ -0,0 +1,53 @@
+#!/usr/bin/env python3
+import os
+import sys
+import time
+
+DEFAULT_SIZE = 16
+MIN_DEPTH = 4
+
+
+def bottom_up_tree(depth):
+    """A tree node is a (left, right) tuple; leaves are (None, None)."""
+    if depth <= 0:
+        return (None, None)
+    depth -= 1
+    return (bottom_up_tree(depth), bottom_up_tree(depth))
+
+
+def check(node):
+    left, right = node
+    if left is None:
+        return 1
+    return 1 + check(left) + check(right)
+
+
+def param(index, env, default):
+    """Positional argument at index, then environment variable, then default."""
+    value = sys.argv[index] if len(sys.argv) > index else os.environ.get(env, "")
+    return int(value) if value else default
+
+
+def main():
+    max_depth = max(MIN_DEPTH + 2, param(1, "BENCH_SIZE", DEFAULT_SIZE))
+
+    start = time.perf_counter()
+
+    stretch_depth = max_depth + 1
+    print(f"stretch tree of depth {stretch_depth}\t check: {check(bottom_up_tree(stretch_depth))}")
+
+    long_lived = bottom_up_tree(max_depth)
+
+    for depth in range(MIN_DEPTH, max_depth + 1, 2):
+        iterations = 1 << (max_depth - depth + MIN_DEPTH)
+        total = 0
+        for _ in range(iterations):
+            total += check(bottom_up_tree(depth))
+        print(f"{iterations}\t trees of depth {depth}\t check: {total}")
+
+    print(f"long lived tree of depth {max_depth}\t check: {check(long_lived)}")
+    print(f"METRIC kernel_time={(time.perf_counter() - start) * 1000:.3f} unit=ms")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,63 @@
+#!/usr/bin/env python3
+import os
+import sys
+import time
+
+DEFAULT_SIZE = 10
+
+
+def fannkuch(n):
+    """Checksum and maximum number of flips over all permutations of n
+    elements, visited in the Benchmarks Game order."""
+    perm1 = list(range(n))
+    count = [0] * n
+
+    checksum = max_flips = perm_count = 0
+    r = n
+    while True:
+        while r != 1:
+            count[r - 1] = r
+            r -= 1
+
+        perm = perm1[:]
+        flips = 0
+        k = perm[0]
+        while k:
+            perm[: k + 1] = perm[k::-1]
+            flips += 1
+            k = perm[0]
+        max_flips = max(max_flips, flips)
+        checksum += flips if perm_count % 2 == 0 else -flips
+
+        # Advance to the next permutation
+        while True:
+            if r == n:
+                return checksum, max_flips
+            perm0 = perm1[0]
+            perm1[:r] = perm1[1 : r + 1]
+            perm1[r] = perm0
+            count[r] -= 1
+            if count[r] > 0:
+                break
+            r += 1
+        perm_count += 1
+
+
+def param(index, env, default):
+    """Positional argument at index, then environment variable, then default."""
+    value = sys.argv[index] if len(sys.argv) > index else os.environ.get(env, "")
+    return int(value) if value else default
+
+
+def main():
+    n = param(1, "BENCH_SIZE", DEFAULT_SIZE)
+
+    start = time.perf_counter()
+
+    checksum, max_flips = fannkuch(n)
+    print(f"{checksum}\nPfannkuchen({n}) = {max_flips}")
+    print(f"METRIC kernel_time={(time.perf_counter() - start) * 1000:.3f} unit=ms")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,75 @@
+#!/usr/bin/env python3
+import os
+import sys
+import time
+from collections import Counter
+
+DEFAULT_SIZE = 1000000
+DEFAULT_SEED = 42
+
+# Linear congruential generator used by the Benchmarks Game fasta program
+IM = 139968
+IA = 3877
+IC = 29573
+
+# Splits [0, IM) into a, c, g and t with the fasta homo sapiens frequencies,
+# as integers so every language picks the same nucleotides
+THRESHOLDS = (42404, 70116, 97766)
+
+
+def generate(size, seed):
+    """DNA sequence of the given length."""
+    last = seed % IM
+    seq = []
+    for _ in range(size):
+        last = (last * IA + IC) % IM
+        if last < THRESHOLDS[0]:
+            seq.append("A")
+        elif last < THRESHOLDS[1]:
+            seq.append("C")
+        elif last < THRESHOLDS[2]:
+            seq.append("G")
+        else:
+            seq.append("T")
+    return "".join(seq)
+
+
+def frequencies(seq, k):
+    """Counts every substring of length k."""
+    return Counter(seq[i : i + k] for i in range(len(seq) - k + 1))
+
+
+def sorted_frequencies(seq, k):
+    """K-mer frequencies in percent, most frequent first and ties broken
+    alphabetically."""
+    counts = frequencies(seq, k)
+    total = sum(counts.values())
+    lines = []
+    for key, n in sorted(counts.items(), key=lambda kv: (-kv[1], kv[0])):
+        lines.append(f"{key} {100 * n / total:.3f}\n")
+    return "".join(lines)
+
+
+def param(index, env, default):
+    """Positional argument at index, then environment variable, then default."""
+    value = sys.argv[index] if len(sys.argv) > index else os.environ.get(env, "")
+    return int(value) if value else default
+
+
+def main():
+    size = param(1, "BENCH_SIZE", DEFAULT_SIZE)
+    seed = param(2, "BENCH_SEED", DEFAULT_SEED)
+
+    start = time.perf_counter()
+
+    seq = generate(size, seed)
+
+    print(sorted_frequencies(seq, 1))
+    print(sorted_frequencies(seq, 2))
+    for fragment in ("GGT", "GGTA", "GGTATT", "GGTATTTTAATT", "GGTATTTTAATTTATAGT"):
+        print(f"{frequencies(seq, len(fragment))[fragment]}\t{fragment}")
+    print(f"METRIC kernel_time={(time.perf_counter() - start) * 1000:.3f} unit=ms")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,55 @@
+#!/usr/bin/env python3
+import math
+import os
+import sys
+import time
+
+DEFAULT_SIZE = 1000
+
+
+def a(i, j):
+    """Entry (i, j) of the infinite matrix A."""
+    return 1.0 / ((i + j) * (i + j + 1) // 2 + i + 1)
+
+
+def multiply_av(v):
+    n = len(v)
+    return [sum(a(i, j) * v[j] for j in range(n)) for i in range(n)]
+
+
+def multiply_atv(v):
+    n = len(v)
+    return [sum(a(j, i) * v[j] for j in range(n)) for i in range(n)]
+
+
+def multiply_atav(v):
+    return multiply_atv(multiply_av(v))
+
+
+def param(index, env, default):
+    """Positional argument at index, then environment variable, then default."""
+    value = sys.argv[index] if len(sys.argv) > index else os.environ.get(env, "")
+    return int(value) if value else default
+
+
+def main():
+    n = param(1, "BENCH_SIZE", DEFAULT_SIZE)
+
+    start = time.perf_counter()
+
+    u = [1.0] * n
+    v = u
+    for _ in range(10):
+        v = multiply_atav(u)
+        u = multiply_atav(v)
+
+    vbv = vv = 0.0
+    for i in range(n):
+        vbv += u[i] * v[i]
+        vv += v[i] * v[i]
+    print(f"{math.sqrt(vbv / vv):.9f}")
+    print(f"METRIC kernel_time={(time.perf_counter() - start) * 1000:.3f} unit=ms")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,73 @@
+use std::env;
+use std::time::Instant;
+
+const DEFAULT_SIZE: u32 = 16;
+const MIN_DEPTH: u32 = 4;
+
+struct Node {
+    children: Option<(Box<Node>, Box<Node>)>,
+}
+
+fn bottom_up_tree(depth: u32) -> Box<Node> {
+    if depth == 0 {
+        return Box::new(Node { children: None });
+    }
+    Box::new(Node {
+        children: Some((bottom_up_tree(depth - 1), bottom_up_tree(depth - 1))),
+    })
+}
+
+fn check(node: &Node) -> u64 {
+    match &node.children {
+        None => 1,
+        Some((left, right)) => 1 + check(left) + check(right),
+    }
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let max_depth = param(1, "BENCH_SIZE", DEFAULT_SIZE).max(MIN_DEPTH + 2);
+
+    let start = Instant::now();
+
+    let stretch_depth = max_depth + 1;
+    println!(
+        "stretch tree of depth {}\t check: {}",
+        stretch_depth,
+        check(&bottom_up_tree(stretch_depth))
+    );
+
+    let long_lived = bottom_up_tree(max_depth);
+
+    let mut depth = MIN_DEPTH;
+    while depth <= max_depth {
+        let iterations = 1u64 << (max_depth - depth + MIN_DEPTH);
+        let mut total = 0;
+        for _ in 0..iterations {
+            total += check(&bottom_up_tree(depth));
+        }
+        println!("{}\t trees of depth {}\t check: {}", iterations, depth, total);
+        depth += 2;
+    }
+
+    println!(
+        "long lived tree of depth {}\t check: {}",
+        max_depth,
+        check(&long_lived)
+    );
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
@@ -1,3 +1,9 @@
+use std::env;
+use std::time::Instant;
+
+const DEFAULT_SIZE: usize = 10000;
+const DEFAULT_SEED: u32 = 42;
+
 fn bubble_sort(arr: &mut [i32]) {
     let n = arr.len();
     for i in 0..n {
@@ -9,8 +15,48 @@ fn bubble_sort(arr: &mut [i32]) {
     }
 }
 
+/// Pseudo-random values in [0, 10000) using xorshift32, matching the other languages.
+fn generate(size: usize, seed: u32) -> Vec<i32> {
+    let mut state = if seed == 0 { 1 } else { seed };
+    (0..size)
+        .map(|_| {
+            state ^= state << 13;
+            state ^= state >> 17;
+            state ^= state << 5;
+            (state % 10000) as i32
+        })
+        .collect()
+}
+
+fn checksum(arr: &[i32]) -> u64 {
+    arr.iter()
+        .enumerate()
+        .fold(0u64, |sum, (i, &v)| (sum + (i as u64 + 1) * v as u64) % 1_000_000_007)
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
 fn main() {
-    let numbers: [i32; 10000] = [8928, 3196, 6956, 9209, 8200, 2331, 2043, 7656, 2408, 5697, 7968, 9378, 2920, 644, 1563, 2466, 7553, 4013, 6814, 5442, 8637, 657, 7816, 9257, 1739, 9230, 5863, 7866, 2670, 5349, 2211, 7668, 5029, 4876, 7672, 2568, 1011, 8474, 5852, 8667, 7292, 9231, 6830, 9405, 4754, 3160, 8618, 7652, 9436, 7192, 5102, 1223, 6988, 201, 7300, 8850, 4359, 1274, 9066, 859, 6375, 9631, 6279, 2373, 7995, 2457, 6769, 1122, 5034, 2207, 3510, 4820, 9742, 1975, 9315, 5156, 4005, 9741, 4251, 1960, 7926, 1188, 9983, 4219, 9640, 3012, 9704, 8331, 1172, 855, 8701, 1951, 3672, 426, 1226, 1703, 5422, 4184, 1960, 8486, 505, 84, 8442, 7994, 8968, 528, 5708, 1411, 7161, 3646, 6045, 946, 5708, 1695, 5053, 2525, 2886, 4265, 9868, 1669, 2201, 6893, 4723, 8500, 9793, 1875, 6643, 8833, 1467, 9833, 8193, 9178, 5854, 7633, 9687, 9641, 9577, 6228, 4467, 5913, 6923, 6789, 7013, 867, 141, 207, 2931, 1603, 4755, 8715, 2878, 1905, 1999, 1474, 3400, 7646, 4587, 3797, 8829, 8063, 7814, 7031, 3665, 5557, 9044, 6022, 8869, 5476, 6861, 8288, 5499, 9544, 7944, 2511, 2929, 9053, 3863, 3826, 2049, 6732, 6521, 7711, 5894, 1198, 7517, 6256, 9537, 3696, 7980, 385, 3227, 2399, 6217, 6287, 8191, 8034, 9939, 8068, 1212, 3211, 6081, 7038, 6977, 1907, 205, 2900, 3752, 479, 4462, 875, 217, 8309, 9518, 8004, 5001, 7198, 7704, 1703, 1997, 358, 6946, 6101, 7795, 7722, 3037, 7813, 2244, 1230, 7083, 2244, 7863, 7149, 8113, 4021, 5737, 9502, 1632, 4481, 45, 1979, 3196, 1306, 1138, 6590, 1762, 9381, 9433, 8096, 8187, 1056, 4752, 2309, 1435, 3432, 6183, 4653, 8777, 2676, 7992, 1850, 7456, 2235, 8402, 9026, 5804, 5066, 8266, 3174, 4900, 448, 2960, 1507, 4649, 175, 2662, 1974, 4098, 23, 9388, 7417, 6566, 3458, 6300, 2040, 1818, 7702, 355, 817, 6027, 1553, 6848, 4924, 6629, 6470, 1504, 9680, 7869, 9858, 3768, 9882, 9475, 6200, 5299, 9339, 3726, 8217, 6255, 3855, 711, 9957, 3097, 8532, 9851, 6824, 3651, 8532, 123, 5449, 5674, 34, 830, 2362, 7826, 3094, 3200, 4766, 7629, 5628, 5814, 4524, 4436, 9534, 929, 3285, 839, 1416, 9378, 5974, 9155, 7652, 9234, 9584, 9573, 7189, 7631, 6554, 2769, 8739, 876, 4830, 2595, 5769, 5887, 8326, 3791, 1909, 6263, 6214, 2338, 4986, 826, 2667, 1553, 714, 5815, 9743, 7415, 1614, 5370, 9439, 1688, 4634, 5120, 6212, 5140, 2873, 7858, 4164, 8660, 8247, 2523, 1620, 4464, 3362, 1702, 6789, 4884, 6419, 2236, 9012, 6572, 5555, 7203, 2055, 9830, 9349, 9736, 3317, 4495, 3509, 707, 3010, 1929, 7801, 8852, 5347, 9938, 430, 1293, 9258, 5435, 5419, 8004, 6761, 5941, 2791, 9517, 6424, 2638, 269, 3327, 6997, 3536, 7409, 5520, 7453, 8080, 1246, 1033, 4925, 1046, 7091, 8347, 3035, 3545, 8099, 6510, 322, 2824, 5944, 4347, 4243, 2909, 4815, 2898, 2377, 6264, 4288, 2509, 7895, 3503, 14, 3867, 5946, 6752, 2062, 6494, 484, 2803, 7018, 2047, 5201, 7277, 5967, 5640, 9052, 8210, 2495, 3321, 8135, 7391, 1789, 5827, 9215, 8142, 827, 5271, 6919, 1333, 414, 6922, 4009, 5987, 7873, 3866, 5162, 7727, 2510, 6898, 33, 9677, 7102, 6732, 5875, 6950, 3114, 2332, 1798, 6993, 2437, 2098, 8481, 10, 5747, 3708, 97, 4776, 4099, 8297, 824, 7975, 9219, 740, 2948, 4545, 461, 5804, 200, 6024, 6176, 9844, 4408, 6693, 7519, 8608, 3375, 4764, 8608, 1940, 4870, 4464, 8002, 9939, 4, 855, 8764, 5340, 2615, 3773, 12, 6344, 8330, 8153, 8328, 141, 6170, 8785, 864, 5336, 4944, 6443, 3550, 4284, 2073, 3469, 1874, 3513, 3453, 34, 681, 7575, 4355, 649, 9341, 8070, 2808, 3897, 7056, 4127, 4832, 5931, 4599, 7483, 472, 7394, 933, 6776, 3828, 1065, 9188, 2321, 5580, 4568, 2971, 9010, 9738, 4945, 1680, 2260, 7164, 330, 6470, 8283, 5811, 1477, 1519, 4355, 3820, 4997, 5795, 3590, 2820, 7397, 3160, 7714, 8902, 6286, 4267, 6865, 894, 7349, 4951, 6998, 405, 5234, 5604, 290, 211, 7271, 7442, 5625, 6005, 4774, 5080, 5979, 5381, 2912, 1310, 3070, 5548, 9671, 2292, 9275, 843, 61, 389, 7717, 5346, 1895, 1838, 289, 7474, 846, 9848, 555, 3351, 1391, 4935, 354, 1586, 5545, 798, 6002, 4850, 2381, 2503, 258, 3620, 5662, 6111, 842, 3742, 2919, 1720, 982, 8565, 2301, 1022, 3775, 9260, 4146, 7983, 4085, 4731, 671, 3578, 9919, 9324, 3874, 2543, 7842, 9239, 6574, 4818, 766, 9383, 9684, 1221, 4319, 1013, 8193, 9799, 9618, 1046, 5918, 9247, 72, 1703, 8392, 9235, 6943, 7711, 9418, 7869, 6935, 5291, 1562, 7081, 1768, 3348, 6954, 8529, 8334, 1813, 1294, 2495, 5557, 5847, 6592, 6420, 4560, 1880, 2295, 2422, 9613, 1722, 1771, 3096, 6731, 5776, 9924, 7339, 481, 5346, 6710, 5592, 2921, 787, 2440, 7260, 4210, 2746, 4389, 5125, 8959, 8554, 5657, 108, 4254, 4827, 3640, 1295, 7099, 7836, 423, 6453, 357, 2871, 646, 6708, 6922, 9546, 165, 9432, 8292, 2541, 1376, 5222, 2888, 1468, 1391, 7644, 4981, 6280, 2988, 205, 2014, 4108, 5484, 2569, 6822, 9074, 257, 7505, 9913, 7371, 2213, 1833, 3537, 602, 6801, 2807, 8920, 2132, 8313, 5757, 2903, 4467, 5584, 9101, 1053, 8565, 1660, 6822, 5436, 5789, 9196, 5877, 9374, 8279, 9108, 2610, 1986, 7980, 2276, 2857, 7973, 8666, 179, 6093, 7910, 1348, 8003, 7970, 5435, 8515, 8802, 2488, 4917, 2126, 9247, 3789, 651, 8190, 9947, 7470, 5924, 7188, 4066, 6209, 7579, 1448, 8731, 8597, 7995, 7130, 4276, 8239, 7462, 9994, 3099, 1159, 6244, 7674, 5957, 9706, 5236, 3232, 2973, 5424, 917, 2542, 9406, 388, 5427, 291, 1523, 3058, 8108, 8166, 8355, 2571, 5986, 8711, 6803, 270, 7578, 97, 5965, 9349, 5836, 3861, 199, 7633, 1346, 5024, 995, 6957, 4886, 5568, 878, 8757, 4832, 1970, 6007, 6437, 9924, 1184, 9173, 3584, 4783, 3574, 3137, 6418, 9549, 6201, 1097, 6327, 4561, 5610, 3242, 3357, 2753, 9831, 3714, 7180, 629, 4049, 8038, 877, 8188, 8297, 479, 5703, 9561, 3824, 4830, 7841, 4599, 8741, 4953, 5728, 2777, 356, 9298, 3403, 8535, 5049, 5132, 6189, 2065, 3474, 6276, 8723, 9322, 6632, 6908, 984, 429, 1928, 6627, 4324, 2919, 6656, 3761, 6974, 2216, 4545, 3114, 4380, 3257, 8322, 4690, 6319, 8903, 2483, 8301, 1893, 3366, 672, 6969, 4664, 1907, 8527, 8668, 6006, 1999, 5253, 9158, 8298, 9139, 767, 452, 5101, 7176, 1136, 3820, 4851, 5432, 2966, 6257, 399, 6402, 5400, 5118, 4209, 1481, 3267, 871, 4737, 353, 2997, 4125, 4953, 3787, 7988, 1382, 4289, 8526, 8479, 7022, 4889, 9151, 8510, 3052, 6236, 4509, 2628, 2832, 7768, 1496, 8767, 2085, 166, 6421, 5737, 5606, 1329, 6656, 2295, 5935, 434, 9529, 140, 4799, 8132, 2169, 1657, 4079, 7043, 8785, 3256, 59, 8405, 1211, 7330, 6611, 5282, 244, 3762, 5554, 9950, 5216, 8521, 4140, 4753, 2467, 4050, 4526, 4247, 6542, 6837, 8966, 9362, 8159, 7695, 7532, 6353, 3087, 375, 1123, 5009, 1700, 4943, 4179, 9644, 5185, 9284, 9782, 560, 2533, 212, 3772, 1664, 7054, 2509, 3022, 9483, 6353, 1313, 8805, 3827, 6861, 5986, 7352, 2490, 5506, 3568, 8352, 1184, 1229, 8447, 7805, 2429, 7587, 571, 95, 4455, 8571, 6123, 3094, 955, 7588, 923, 5286, 3396, 6325, 147, 9434, 4412, 7167, 8614, 7147, 4079, 176, 1696, 9639, 4471, 3534, 6966, 3274, 2934, 298, 9560, 4416, 8765, 5570, 3331, 2273, 5617, 5629, 6007, 5748, 1645, 9968, 1773, 1747, 340, 952, 2025, 7782, 3576, 2761, 3705, 453, 1425, 3511, 1563, 2569, 201, 7814, 1001, 6689, 4952, 7440, 290, 9984, 7077, 8169, 5015, 6472, 662, 7972, 3760, 6616, 9587, 1371, 4854, 3192, 7292, 6860, 9398, 4329, 9276, 8922, 2633, 8950, 2215, 260, 5701, 4615, 9753, 5531, 7941, 6561, 1339, 3615, 870, 9473, 9676, 7953, 4764, 7247, 5137, 6214, 2047, 4652, 1232, 9815, 8393, 6720, 5964, 4021, 8599, 6131, 487, 2115, 9337, 6535, 3864, 4781, 8345, 7156, 9595, 4739, 6573, 9833, 1525, 7251, 8655, 6555, 359, 5262, 1505, 6399, 7257, 6262, 9977, 9644, 3924, 4355, 5726, 894, 1367, 3267, 1451, 1216, 5855, 4143, 8079, 9617, 9867, 5346, 2972, 7007, 816, 4522, 206, 557, 9654, 638, 9098, 6806, 6641, 626, 2117, 7113, 4937, 2890, 5342, 2695, 7387, 1162, 4556, 1229, 6020, 7936, 9329, 6225, 2964, 5743, 2401, 5834, 7823, 9791, 4227, 1757, 2101, 2373, 9997, 8887, 7756, 1414, 1195, 4798, 3567, 5188, 8331, 1332, 8880, 215, 1590, 7506, 19, 1881, 2064, 6929, 7297, 74, 4909, 4064, 9758, 7274, 5961, 3910, 1467, 2103, 9395, 6856, 5407, 7846, 4018, 6445, 5150, 9153, 8955, 1049, 8528, 3080, 6551, 2797, 154, 2646, 5644, 9970, 5266, 8312, 1184, 9507, 5646, 8485, 4359, 6863, 7909, 3710, 8363, 8432, 3474, 2620, 5449, 3590, 4912, 9479, 8877, 8110, 674, 1853, 3989, 8531, 5712, 3652, 7993, 3978, 1685, 598, 5553, 8926, 4882, 926, 3583, 595, 9134, 8494, 205, 9426, 7760, 1773, 4367, 2656, 1821, 7913, 2348, 1231, 7160, 8604, 3210, 370, 5430, 3614, 8467, 8618, 2826, 9942, 725, 5718, 3942, 3410, 7199, 3119, 1008, 3729, 652, 2746, 8527, 3889, 5763, 3243, 9177, 886, 7063, 7751, 2368, 1776, 5160, 3203, 3030, 2416, 81, 2745, 9342, 3964, 6461, 4768, 1298, 4544, 9221, 6292, 3526, 1792, 7089, 7317, 5779, 3932, 2548, 5589, 5741, 2386, 4340, 4897, 2894, 4331, 8474, 7171, 9334, 934, 7123, 1211, 4390, 5350, 3010, 5204, 3954, 4933, 6657, 5767, 1666, 4699, 6790, 8424, 3381, 3206, 4276, 2888, 6851, 346, 5013, 3957, 9473, 5987, 7797, 4543, 8566, 4049, 9408, 9188, 5889, 4239, 3017, 9701, 9119, 2238, 6058, 4948, 2811, 7137, 3624, 3136, 5786, 1526, 5469, 5173, 7824, 4013, 8743, 1031, 5117, 6953, 7190, 6157, 4487, 4225, 3555, 3228, 3016, 8446, 1806, 8232, 8832, 537, 7092, 7145, 1885, 4748, 886, 6481, 2985, 8598, 6659, 7920, 5951, 6209, 5938, 3363, 1672, 418, 1656, 4559, 4580, 1478, 5233, 6850, 750, 5874, 4622, 1859, 4865, 8910, 8048, 4687, 4190, 5921, 1017, 9019, 6624, 8984, 208, 9236, 5001, 1856, 6851, 881, 4710, 644, 9377, 6111, 9893, 9395, 2332, 7195, 3774, 1769, 2851, 41, 9434, 7441, 791, 7308, 4216, 3830, 8422, 6205, 7214, 8845, 6853, 8784, 1560, 749, 7156, 9978, 6799, 3279, 4021, 9803, 7061, 6642, 9970, 8789, 7367, 9854, 8919, 3677, 2350, 5960, 6143, 7726, 786, 1714, 8972, 3397, 4400, 1783, 1453, 2551, 9042, 5440, 6961, 4626, 5769, 2522, 9931, 3529, 9485, 9993, 9856, 3961, 7646, 3770, 464, 2315, 9389, 6421, 8466, 4287, 4269, 1556, 6288, 1629, 9526, 3757, 3994, 686, 9485, 2744, 4150, 8399, 6761, 1062, 6155, 3154, 6906, 5356, 9957, 8790, 2501, 7902, 342, 3763, 6889, 9923, 7380, 5608, 2566, 1007, 6668, 4730, 9216, 6688, 9076, 3952, 7884, 5693, 2627, 3939, 8345, 8815, 1465, 8100, 3631, 7739, 1806, 9999, 1785, 7375, 8440, 446, 8318, 6970, 3529, 7297, 5259, 6829, 6092, 6966, 1949, 4944, 1758, 3267, 4324, 7413, 278, 9498, 9795, 4454, 4167, 9823, 7881, 1637, 1624, 4473, 7735, 102, 2003, 5916, 9517, 7935, 6564, 7038, 6310, 6456, 7217, 1027, 3509, 2884, 5120, 414, 6140, 2924, 4266, 8539, 8890, 8894, 8465, 261, 9406, 266, 288, 3646, 3552, 7787, 7446, 4190, 2904, 9635, 7741, 3926, 5498, 2310, 9696, 8792, 7407, 2818, 5136, 6619, 7124, 6431, 8520, 6120, 2477, 9765, 7203, 4374, 5831, 4770, 5143, 5515, 8243, 7129, 6821, 3671, 6751, 2703, 2195, 6477, 4336, 8488, 560, 9006, 9191, 8832, 952, 8646, 4108, 5128, 4526, 5870, 2685, 731, 9487, 8108, 2918, 9664, 9296, 7303, 1929, 3248, 3062, 7798, 1250, 3669, 7838, 9434, 7764, 688, 5587, 4054, 1719, 4280, 114, 2985, 2770, 7510, 5695, 2633, 9515, 1640, 4426, 9704, 4880, 3314, 6737, 7774, 2034, 8571, 522, 8871, 1838, 766, 7321, 5741, 168, 1392, 1241, 7242, 2736, 9067, 538, 6326, 3997, 8114, 7609, 4459, 3991, 4344, 1168, 495, 4052, 7352, 4505, 6623, 1785, 7341, 4926, 897, 269, 3689, 6925, 759, 8099, 9760, 660, 8963, 3154, 4605, 4480, 2342, 9914, 6388, 6838, 4231, 378, 4411, 222, 3482, 6261, 5120, 8520, 8758, 2247, 1982, 2744, 523, 3898, 3429, 8175, 7425, 9496, 2087, 4441, 3603, 9351, 5812, 3942, 9714, 9138, 9520, 8968, 1609, 2024, 9676, 2067, 7787, 7606, 9561, 5821, 6175, 1674, 3238, 2362, 7627, 3120, 6250, 8789, 990, 7372, 7682, 4565, 8354, 9411, 9722, 4978, 9577, 8187, 3249, 3013, 3599, 2785, 4871, 3465, 4939, 4378, 241, 5249, 8761, 5432, 6334, 4599, 2331, 3140, 5931, 1753, 1631, 1047, 8386, 174, 4834, 8721, 9349, 7110, 622, 9863, 1460, 8209, 5742, 8932, 5315, 9064, 2871, 7889, 3996, 6617, 9289, 917, 3081, 7800, 4681, 7484, 4087, 4068, 2544, 1696, 9640, 2365, 6728, 4529, 2736, 6154, 4095, 9182, 9626, 5876, 59, 4586, 2491, 1295, 5700, 2047, 3470, 9006, 5197, 5511, 1783, 5511, 6382, 2014, 4293, 2056, 5249, 5027, 6970, 8862, 917, 1540, 7544, 3522, 4454, 253, 3496, 2109, 7911, 6776, 2583, 2282, 4083, 4827, 8470, 1624, 6017, 9009, 6549, 7081, 1861, 3199, 69, 3940, 4023, 6805, 7199, 810, 5935, 3196, 2734, 2837, 601, 3488, 1036, 9961, 4406, 9446, 5753, 2213, 9738, 6495, 5060, 4895, 702, 7547, 1750, 5680, 3540, 1321, 3463, 5253, 7342, 8395, 7350, 8589, 2198, 50, 9743, 5667, 3148, 5118, 6266, 7991, 4812, 4513, 5149, 1330, 5354, 4737, 1129, 1050, 6248, 140, 6536, 1970, 7401, 2633, 4509, 9050, 3687, 5591, 6111, 8120, 4301, 5518, 6163, 9328, 4887, 3837, 5100, 8427, 8994, 2738, 1318, 7119, 420, 3407, 6950, 6918, 8225, 3465, 679, 8969, 4724, 6021, 7739, 6165, 4679, 1991, 5806, 681, 2902, 4760, 2297, 9808, 502, 3478, 9456, 2032, 8470, 8341, 2660, 1067, 6793, 9554, 2668, 9875, 5298, 7047, 353, 4289, 5074, 4481, 2256, 8667, 856, 2202, 3444, 4072, 1033, 2949, 9355, 3230, 1336, 1262, 5409, 1765, 7708, 1140, 8532, 5157, 87, 770, 7625, 978, 3765, 3140, 6577, 7006, 7495, 1244, 2521, 5495, 1145, 5, 6137, 250, 4165, 6176, 9898, 6462, 9847, 4652, 5762, 9684, 7628, 974, 7167, 4472, 6136, 4564, 5217, 2932, 3554, 2066, 2838, 2010, 6384, 5497, 5439, 104, 2432, 3020, 1006, 4981, 4586, 1849, 5106, 8697, 6207, 9841, 5564, 4933, 1548, 2655, 5231, 2542, 1366, 790, 2274, 8438, 4791, 1797, 6410, 2272, 6421, 5456, 3262, 3584, 776, 1315, 2243, 8045, 2845, 5421, 9743, 2595, 7961, 4988, 7462, 2061, 5790, 9945, 538, 6704, 9969, 9711, 9653, 218, 3745, 6826, 6800, 9179, 5565, 1105, 3533, 9485, 1048, 7243, 6376, 7432, 5229, 9302, 2613, 8144, 7078, 3236, 3287, 7354, 6386, 5896, 456, 4245, 8089, 3430, 8159, 1989, 381, 98, 4609, 1783, 3320, 3009, 7142, 7360, 1379, 9713, 2988, 4609, 468, 4111, 242, 1998, 5410, 3702, 2069, 3690, 5592, 9855, 8825, 7790, 2448, 3411, 9153, 5996, 6492, 1911, 9502, 7620, 3293, 5961, 1308, 4867, 4162, 3352, 2409, 6933, 8423, 4020, 547, 5715, 3670, 8653, 6206, 380, 2406, 3880, 4729, 5007, 6108, 7086, 1356, 1, 7796, 1101, 679, 5956, 7666, 6761, 6883, 232, 1684, 8303, 3499, 5183, 6459, 8463, 9917, 2516, 4604, 2239, 335, 6107, 2415, 3999, 5332, 9104, 4918, 750, 2824, 5271, 3242, 5396, 5778, 3109, 8631, 4997, 8114, 9235, 8471, 9620, 9818, 5283, 5908, 5779, 9515, 7988, 3293, 1092, 2215, 5805, 5520, 7367, 8492, 9717, 6548, 341, 7501, 6203, 722, 1569, 1540, 6102, 9477, 6751, 8343, 1783, 6368, 8794, 9999, 6267, 695, 4252, 1678, 4978, 2466, 7556, 1434, 769, 9246, 2735, 9805, 3667, 809, 7834, 4594, 184, 2064, 8490, 4896, 1088, 7019, 6922, 7466, 7681, 5040, 8851, 4667, 6769, 5035, 562, 5768, 5490, 4666, 6334, 2156, 2556, 2740, 9366, 3036, 7788, 1028, 6308, 6785, 8209, 1572, 9222, 6389, 7147, 9448, 1017, 1151, 6807, 999, 5286, 8424, 989, 8636, 4497, 1870, 5133, 8022, 1488, 2796, 6059, 8376, 847, 9715, 3780, 769, 1122, 7810, 5402, 4127, 5315, 9576, 7483, 7762, 5327, 9078, 7831, 767, 183, 3194, 8685, 9891, 9877, 3749, 9373, 133, 2683, 7519, 7756, 980, 4304, 2338, 6081, 5038, 1472, 394, 188, 6340, 1468, 4748, 7064, 789, 6154, 3185, 3873, 5260, 9232, 9549, 7624, 2766, 984, 8597, 7443, 2776, 8842, 6408, 4049, 4346, 7410, 9094, 3976, 1525, 4301, 6525, 7481, 5222, 7376, 9654, 6526, 7742, 4264, 5423, 9768, 4179, 7513, 5935, 9449, 8262, 2011, 8336, 3396, 4327, 5182, 2076, 6834, 2632, 11, 4072, 3704, 1926, 8838, 689, 5214, 6518, 160, 3978, 3440, 3218, 4206, 5510, 8318, 5842, 144, 8418, 610, 4125, 1522, 8690, 3824, 2884, 6285, 9300, 8766, 6317, 8993, 6460, 6656, 3257, 7758, 4157, 4689, 9892, 1381, 3934, 9785, 6174, 6964, 8118, 9836, 4157, 8281, 6749, 1271, 6181, 6400, 545, 1944, 5564, 5388, 9268, 8744, 2795, 4742, 1571, 839, 456, 1714, 481, 3445, 4205, 8098, 6175, 9923, 6069, 3476, 2863, 6358, 7580, 5050, 500, 4363, 7859, 6825, 6358, 5526, 1049, 7609, 3082, 2201, 1024, 6707, 856, 678, 7765, 7495, 9864, 2373, 1631, 3917, 8521, 4324, 5746, 4040, 7667, 4939, 6561, 8827, 1099, 799, 5161, 8649, 332, 4191, 4468, 5580, 1897, 3411, 2231, 7401, 4811, 827, 3021, 9601, 1074, 9341, 4331, 865, 6554, 6329, 8958, 2350, 2021, 9344, 3254, 465, 910, 2974, 3214, 2136, 9318, 3980, 694, 1533, 597, 4302, 8472, 8375, 5674, 2627, 9038, 5993, 650, 2518, 5916, 9185, 8470, 4905, 776, 8734, 1193, 2100, 4886, 3193, 7302, 9242, 9368, 6872, 4397, 5225, 9740, 8866, 49, 3806, 5937, 7057, 8234, 1729, 5873, 3497, 7261, 7544, 6057, 105, 6060, 4541, 6196, 6219, 8786, 1862, 4885, 1982, 6474, 5240, 3608, 6582, 8755, 9073, 9359, 3347, 308, 6268, 5230, 1262, 6918, 6440, 553, 7105, 9929, 9597, 3533, 264, 2362, 1646, 1255, 8442, 6676, 6984, 6006, 1984, 2516, 3531, 1205, 8930, 1639, 5402, 4132, 5886, 6403, 1758, 8711, 5538, 2328, 4793, 3953, 6811, 8113, 5552, 5505, 5683, 1770, 1902, 4649, 4745, 8120, 5409, 5688, 3978, 2490, 5209, 4329, 9918, 4150, 8507, 2835, 4343, 8098, 2531, 6311, 3112, 9745, 4416, 791, 5503, 3768, 3409, 7916, 6454, 8548, 8912, 2732, 6302, 6252, 9792, 7921, 3499, 9122, 2342, 269, 7450, 4043, 7178, 6632, 7875, 6643, 5348, 4557, 9225, 2551, 6582, 8752, 5549, 643, 9437, 3069, 4468, 5002, 9056, 4531, 4163, 2101, 5271, 8892, 9557, 7634, 2303, 6826, 1882, 260, 2385, 9653, 41, 4900, 4696, 4158, 7288, 6936, 1523, 2201, 3928, 6305, 3073, 2511, 1654, 5002, 6690, 6929, 7037, 1779, 4382, 6843, 5622, 5074, 1352, 6823, 5831, 9180, 59, 402, 1560, 8322, 3796, 667, 1471, 4075, 8007, 7860, 768, 4627, 7433, 3348, 6912, 2568, 6541, 6963, 3740, 1130, 2494, 9431, 5671, 2487, 9640, 7257, 5876, 5372, 3109, 961, 3643, 4752, 5841, 2990, 8218, 4583, 978, 6862, 3849, 8648, 5796, 7508, 5564, 1279, 2856, 8107, 4133, 2682, 3930, 6269, 9558, 9260, 835, 5555, 7497, 6333, 3739, 2626, 2625, 6118, 1154, 9907, 34, 8622, 3416, 6410, 6862, 1722, 5235, 7720, 5950, 2365, 3585, 7819, 4146, 6103, 2834, 4538, 7080, 7479, 2574, 9410, 141, 1611, 2810, 1474, 8379, 7433, 8587, 5413, 4981, 8622, 1077, 3706, 5824, 5402, 7821, 7384, 3718, 3209, 8239, 5644, 8134, 9513, 6758, 6232, 1418, 4222, 4940, 6593, 7792, 316, 9519, 6414, 8929, 1355, 5790, 8810, 2764, 774, 1951, 6103, 7158, 1384, 1911, 7109, 2340, 3526, 6924, 4739, 52, 8361, 8877, 8780, 922, 9, 5760, 6703, 3889, 711, 5497, 9590, 4420, 6358, 7256, 733, 100, 4863, 6280, 9679, 3358, 3880, 4625, 4077, 6155, 4931, 9772, 5344, 193, 4487, 8296, 6010, 9749, 7028, 2546, 1349, 4525, 2186, 5476, 9356, 8878, 1408, 2129, 9728, 7925, 7585, 5184, 8193, 499, 3078, 5806, 6860, 9727, 8159, 8612, 2474, 7215, 5566, 3570, 150, 1294, 5866, 4656, 6789, 8991, 1073, 4494, 6569, 152, 410, 6027, 3394, 2009, 5013, 6711, 9401, 6806, 8488, 8323, 2122, 6730, 9034, 2858, 310, 2280, 7399, 6677, 8310, 4390, 1354, 9797, 2708, 7811, 5310, 9285, 7941, 9963, 890, 7648, 6880, 38, 2670, 3050, 5979, 3412, 4539, 7384, 6640, 5075, 2476, 8137, 7133, 5368, 7095, 8827, 208, 452, 2893, 9226, 1371, 7961, 2956, 4684, 5014, 288, 7571, 3724, 7354, 8091, 3912, 9644, 1212, 8415, 7980, 2161, 3387, 8545, 1111, 3219, 9134, 11, 5244, 2682, 2957, 6104, 3688, 744, 9720, 7807, 7668, 3859, 3004, 5038, 6440, 5498, 6323, 8312, 5303, 6439, 9724, 5433, 9349, 1751, 7943, 5112, 9688, 818, 5005, 3428, 1289, 483, 3188, 1764, 1352, 4160, 835, 8446, 6528, 5415, 580, 4894, 2573, 2965, 7242, 8212, 3788, 5926, 837, 2422, 2293, 3696, 2864, 4966, 640, 8801, 1002, 7450, 3664, 6902, 8194, 1921, 9421, 5752, 8994, 7885, 3403, 2810, 7599, 4513, 9620, 1, 726, 1298, 2416, 143, 725, 7917, 3322, 6041, 646, 3811, 4707, 8543, 2469, 2636, 2820, 535, 8801, 6341, 4885, 3700, 6693, 6795, 1340, 2739, 4208, 7546, 6964, 1095, 2841, 993, 3635, 9672, 1432, 3081, 3975, 8590, 702, 9421, 1604, 8646, 1758, 8417, 9032, 3813, 8230, 9994, 2883, 9642, 8065, 628, 4087, 4541, 1154, 9255, 3204, 9823, 7977, 8490, 4764, 63, 7852, 9536, 5044, 7472, 946, 5339, 1857, 8981, 8207, 3797, 4481, 4417, 370, 4752, 1108, 3937, 2473, 124, 6905, 522, 4937, 7958, 2373, 2684, 665, 1694, 360, 8288, 7173, 2804, 7054, 9900, 2871, 2464, 6232, 2415, 7261, 1973, 4170, 1253, 8312, 1836, 4962, 7147, 4117, 2797, 4291, 4394, 3190, 736, 6844, 9208, 5770, 8004, 9467, 3956, 9385, 3288, 2041, 7537, 7130, 1137, 4496, 1018, 9105, 2407, 2799, 1507, 6873, 8823, 9565, 5210, 3916, 7700, 9405, 7220, 500, 1222, 3286, 4804, 2261, 1413, 1288, 6446, 1862, 5568, 4450, 2184, 2393, 8844, 6002, 1212, 9556, 7334, 3363, 6952, 2278, 4156, 1362, 5333, 4376, 5272, 1616, 6572, 5139, 6356, 8429, 804, 5428, 7283, 3731, 9015, 1449, 415, 6065, 8166, 4916, 9533, 9822, 5115, 8174, 3118, 9274, 8216, 4990, 7005, 2897, 1634, 3784, 464, 4229, 1754, 1875, 1406, 6195, 5009, 3346, 7173, 2639, 5862, 5896, 8291, 7881, 8751, 5344, 3497, 3424, 2528, 9571, 7374, 5551, 4620, 5826, 9529, 7465, 6824, 7908, 6969, 9434, 4640, 2983, 4544, 2556, 1726, 8437, 5989, 3017, 3772, 2157, 658, 8764, 6550, 4620, 669, 4970, 8328, 8464, 2841, 13, 9613, 2463, 9791, 4630, 4526, 5356, 9306, 4337, 1078, 5537, 3208, 3533, 3337, 7815, 6272, 1270, 3859, 3064, 1346, 2811, 8703, 8792, 5645, 6484, 6121, 8271, 5450, 8713, 602, 4661, 8303, 5296, 7440, 969, 7609, 9098, 1365, 7281, 2658, 1655, 8788, 8744, 3531, 6838, 301, 2898, 4875, 8991, 4501, 3314, 5491, 4847, 171, 113, 2003, 5512, 6341, 1493, 1502, 1090, 4995, 5684, 2049, 6184, 4344, 7961, 9928, 4690, 9684, 9693, 3160, 8530, 7332, 3876, 4774, 5132, 6274, 6072, 1522, 8969, 2033, 959, 3418, 5283, 971, 2966, 9407, 4613, 4721, 6627, 1551, 2997, 661, 9601, 8671, 5596, 3413, 9065, 8202, 7795, 9345, 5697, 9744, 734, 326, 3446, 9494, 8667, 4489, 1364, 3883, 9299, 3486, 922, 7048, 5285, 2393, 1846, 3296, 9888, 5563, 8609, 431, 7615, 6495, 9568, 8214, 5558, 3913, 9175, 9593, 4073, 4265, 1371, 3193, 3220, 6399, 4909, 4221, 5307, 8487, 1377, 9814, 8758, 1376, 7504, 7842, 1146, 482, 4742, 9483, 3989, 4972, 4032, 1779, 831, 909, 6443, 545, 1650, 2846, 8902, 4917, 3776, 8696, 2901, 790, 1618, 16, 4056, 6680, 4347, 3305, 6100, 41, 3545, 6347, 861, 1446, 8546, 9303, 4364, 6553, 5276, 1649, 6490, 6479, 7045, 9302, 789, 2886, 2762, 9051, 4516, 1497, 3132, 7671, 459, 1607, 9082, 4744, 607, 640, 8590, 7338, 8109, 4150, 7044, 7723, 6299, 1517, 6847, 4859, 8199, 6340, 913, 4791, 7187, 4865, 5257, 569, 2638, 3081, 5926, 9309, 8207, 5403, 7201, 251, 5833, 4933, 2622, 471, 2820, 7810, 1880, 9585, 5526, 7526, 5876, 7081, 7314, 8142, 2487, 1316, 2958, 6270, 3936, 861, 8662, 1858, 9868, 4184, 9187, 8886, 5614, 2741, 2884, 25, 7918, 8585, 7321, 131, 3919, 2114, 978, 3582, 8748, 4551, 6567, 1193, 7984, 5671, 8408, 6558, 6054, 9729, 4354, 2319, 1742, 7509, 3539, 1073, 3763, 2875, 7363, 6899, 9594, 8409, 7080, 8456, 8416, 4215, 4075, 7843, 1123, 4045, 7111, 7804, 9230, 5312, 5769, 4714, 9404, 1448, 9050, 2609, 2815, 7763, 7158, 7429, 279, 3204, 1728, 2174, 3430, 9783, 9286, 7260, 3435, 772, 8754, 817, 9584, 6669, 8334, 9636, 3788, 7179, 8143, 6501, 301, 3457, 2185, 3951, 1977, 3253, 5084, 5383, 911, 5476, 6597, 164, 4050, 605, 3842, 9104, 8131, 8070, 3569, 8705, 4648, 988, 9477, 129, 5843, 8154, 8967, 3993, 390, 3554, 3790, 526, 5393, 488, 2594, 592, 346, 6788, 3319, 9203, 987, 6710, 7539, 486, 7531, 2553, 771, 5035, 1476, 8547, 4745, 3580, 5440, 781, 7700, 9698, 6474, 4755, 9009, 1847, 8665, 1451, 5344, 987, 1486, 8165, 3786, 2885, 8251, 1933, 5681, 7179, 8353, 1554, 5264, 5998, 5047, 7501, 2756, 8727, 790, 6588, 9715, 9634, 735, 6072, 9802, 3031, 6870, 5488, 4109, 8538, 535, 1536, 3911, 6223, 7600, 3315, 4083, 2584, 5508, 8990, 2107, 517, 3005, 8192, 9944, 8498, 6663, 677, 8946, 895, 370, 4223, 3595, 6787, 5873, 3584, 8070, 1069, 6448, 5626, 5192, 7905, 2122, 5945, 7522, 746, 9531, 9855, 4920, 428, 9192, 2032, 6719, 7941, 803, 4734, 9211, 2891, 9177, 2877, 9635, 159, 6417, 7767, 5390, 3918, 5718, 8289, 9374, 1232, 1236, 6353, 3465, 2256, 7003, 928, 6789, 1223, 2815, 9261, 8744, 7619, 7492, 1312, 200, 3704, 8143, 7741, 4010, 9347, 6063, 6198, 3899, 9449, 4429, 8657, 2871, 4174, 367, 7947, 2701, 6580, 7431, 706, 1034, 3191, 1713, 6757, 6693, 5546, 7835, 2469, 2715, 6305, 8370, 2739, 1513, 3614, 5346, 7160, 9179, 4977, 8236, 6016, 4944, 878, 4416, 6965, 7545, 1500, 8725, 8052, 5103, 6694, 6046, 7831, 9471, 1325, 5142, 4530, 3515, 4024, 571, 1779, 8084, 5738, 2098, 570, 7622, 2515, 713, 8971, 6815, 5511, 8354, 6026, 8051, 126, 342, 3043, 9491, 3451, 1158, 2935, 1481, 5084, 1245, 4482, 7839, 9887, 5953, 4028, 1313, 268, 4509, 6273, 5787, 397, 1361, 2551, 3419, 8858, 4014, 6218, 2568, 9946, 1814, 1472, 7687, 8455, 7853, 9531, 914, 255, 9472, 2652, 6622, 6225, 610, 9167, 4490, 9601, 409, 2023, 348, 6008, 4454, 9564, 5318, 8689, 4651, 9116, 7144, 5599, 4629, 705, 5180, 2867, 2338, 5508, 3633, 1990, 228, 7728, 6184, 6582, 4519, 6863, 1950, 9182, 5483, 6152, 5623, 3155, 2309, 6444, 9952, 2024, 8204, 6083, 8129, 1326, 2395, 9721, 3469, 4596, 7746, 2601, 4944, 8390, 4056, 7812, 7598, 3217, 5109, 3001, 9970, 5118, 8864, 6326, 3267, 3636, 1777, 4646, 117, 4356, 2145, 408, 5510, 9752, 9436, 7736, 9935, 322, 1297, 4133, 3894, 6895, 4791, 590, 9206, 9753, 6946, 2965, 253, 3279, 7012, 6603, 8187, 4083, 5981, 1096, 3455, 3917, 8384, 8097, 4219, 1831, 1910, 5105, 4626, 4481, 1336, 9945, 1969, 4511, 227, 8301, 1629, 9108, 2236, 9104, 2317, 1146, 9421, 3542, 1382, 3110, 6959, 1537, 8097, 3930, 4607, 8369, 8290, 3699, 2833, 6577, 309, 640, 9650, 7490, 4664, 3274, 3668, 3329, 2785, 9999, 3710, 4497, 5092, 3586, 6991, 9777, 6163, 4408, 4714, 8849, 9355, 361, 7076, 6194, 941, 3399, 9458, 2041, 7966, 3159, 5328, 7957, 387, 4319, 9053, 4626, 9418, 5618, 159, 1136, 8177, 693, 763, 8966, 664, 9047, 7804, 6108, 9742, 4212, 6025, 7520, 8891, 2978, 3322, 9171, 4356, 9004, 5231, 894, 4969, 8066, 1523, 6287, 8994, 6771, 4014, 5682, 6007, 8537, 2317, 6065, 5088, 9158, 5946, 2184, 8434, 4683, 2830, 4228, 5063, 2972, 3343, 3582, 2214, 4184, 1446, 8133, 5016, 8899, 4395, 7519, 7133, 6006, 3325, 2607, 24, 6052, 9290, 5154, 6366, 8036, 3249, 5982, 3400, 2621, 1481, 839, 2902, 3801, 5533, 5577, 6939, 7707, 5792, 4959, 5728, 7211, 1636, 4774, 3418, 8617, 4855, 1681, 6222, 7816, 2574, 4403, 4587, 8548, 4427, 2077, 1075, 2173, 4640, 8448, 2901, 8930, 21, 3123, 9370, 2778, 9820, 5924, 449, 2518, 1164, 9577, 4836, 1672, 1095, 468, 892, 6496, 9191, 8645, 7999, 1642, 4440, 8056, 5814, 9089, 2609, 3831, 9706, 4632, 1968, 722, 4506, 2222, 6776, 6459, 4912, 3198, 2744, 2633, 8397, 6834, 777, 3377, 4128, 7275, 7741, 8964, 3504, 7525, 7246, 8379, 9265, 4521, 8500, 4381, 1873, 1960, 6507, 4009, 9383, 8256, 3147, 3975, 6685, 6262, 9271, 7857, 1502, 9134, 5440, 6164, 327, 9057, 1687, 8921, 8416, 4003, 9948, 7634, 1961, 2203, 851, 9484, 8486, 9219, 8518, 2697, 6882, 5814, 1708, 7976, 4743, 4602, 478, 4983, 3714, 1416, 6883, 4880, 3556, 1652, 4448, 2418, 9192, 8151, 9080, 5616, 3873, 9896, 9800, 6556, 8550, 7738, 2640, 8991, 2868, 4337, 6422, 8883, 5723, 2803, 6381, 239, 9051, 2587, 2900, 3489, 2406, 6282, 1374, 4071, 9163, 4228, 8018, 4464, 9913, 2251, 1607, 3755, 3305, 6356, 9488, 8504, 5363, 9995, 3996, 9198, 3753, 8685, 4232, 1856, 474, 7367, 2210, 5706, 9763, 2495, 3861, 508, 8167, 7211, 6251, 6285, 5244, 198, 940, 3174, 4148, 9316, 7883, 3417, 5730, 9097, 7624, 603, 5283, 8890, 4172, 9118, 2574, 1573, 5087, 5730, 5953, 4112, 5488, 7532, 4481, 1678, 436, 3931, 3827, 1371, 8063, 8847, 6319, 1564, 2104, 965, 3333, 4951, 4961, 2408, 4595, 4600, 6879, 9682, 1993, 3437, 2494, 559, 8001, 162, 9204, 9898, 1977, 2543, 6996, 4861, 7245, 5387, 7527, 7903, 1603, 796, 1248, 7352, 4472, 905, 6380, 8203, 6596, 5739, 478, 1641, 6086, 5458, 969, 403, 9762, 2388, 4828, 1871, 793, 2638, 7892, 578, 6863, 5432, 5299, 9645, 3237, 6079, 4865, 754, 1510, 933, 7518, 890, 7953, 6789, 8960, 289, 4764, 5285, 2336, 6532, 4988, 6844, 4608, 1309, 4776, 2027, 9245, 943, 8992, 5175, 7251, 20, 8468, 2907, 2431, 3561, 4205, 6105, 1503, 436, 1774, 2692, 4825, 1488, 8951, 5277, 8600, 8166, 1795, 4338, 8214, 2983, 6776, 681, 6689, 5859, 2929, 9208, 3169, 1596, 5841, 4646, 8325, 102, 6814, 1058, 3523, 4498, 3374, 9251, 7122, 1099, 7284, 9409, 7792, 1629, 3072, 5241, 9494, 2017, 1656, 5106, 1689, 3618, 1343, 3713, 8238, 5609, 8349, 480, 646, 9448, 219, 9914, 9840, 8441, 9889, 3141, 7560, 9003, 6968, 5012, 2433, 2512, 4715, 2823, 3166, 6709, 9692, 444, 322, 1596, 6538, 5763, 6527, 819, 1353, 8260, 9093, 7990, 4545, 2259, 2871, 8754, 6342, 5189, 6533, 4140, 4626, 8340, 4181, 5817, 1609, 8666, 4170, 2378, 2167, 5062, 3405, 1001, 9000, 7977, 1722, 2358, 4051, 7996, 5010, 6490, 3438, 7507, 9897, 3069, 8653, 364, 5002, 4159, 1803, 5754, 5946, 5599, 3933, 5596, 6029, 5440, 7957, 3469, 8069, 8397, 4972, 1848, 971, 4810, 1394, 1463, 7596, 9794, 3154, 8060, 68, 3002, 6428, 4055, 3258, 6017, 4910, 956, 3811, 3600, 3307, 8377, 4693, 2934, 7443, 6829, 2466, 5997, 4286, 4918, 1016, 6277, 1488, 2076, 1386, 9078, 1745, 8968, 2506, 4231, 7342, 9596, 7377, 1782, 5793, 4842, 8669, 7638, 8725, 4250, 9451, 5117, 7329, 5216, 2558, 285, 9937, 580, 2072, 857, 3788, 9217, 5387, 5539, 9036, 2275, 5026, 8352, 2142, 8373, 1102, 7358, 2861, 7395, 3993, 1090, 3575, 7431, 3081, 2189, 5210, 8579, 5281, 9977, 1081, 9373, 693, 6806, 9491, 9345, 9830, 5050, 7337, 2521, 2495, 1570, 849, 5142, 6860, 1587, 9668, 2888, 4389, 7625, 6058, 5407, 571, 8047, 3076, 5019, 3024, 6555, 9036, 553, 3501, 1547, 1471, 3378, 1878, 2851, 6076, 2658, 3357, 605, 158, 9982, 1387, 122, 8177, 337, 1504, 6929, 2308, 3755, 5893, 9435, 5901, 7495, 7830, 7652, 689, 2018, 7925, 973, 7724, 2936, 3638, 1116, 4129, 9527, 9013, 6117, 7878, 3801, 8785, 1987, 2341, 7385, 1085, 575, 9424, 8389, 6986, 8230, 3219, 8006, 2097, 7455, 7100, 3466, 2565, 7179, 9392, 7639, 3065, 9500, 9249, 8599, 6507, 2261, 2684, 6805, 5994, 2850, 6846, 8030, 2108, 7736, 3339, 6329, 564, 2663, 4713, 8513, 8122, 2689, 1046, 5675, 1583, 3839, 3138, 5607, 6555, 4425, 549, 5241, 7574, 7064, 8723, 5718, 1288, 1722, 9963, 9247, 237, 5230, 1798, 2435, 4484, 2787, 5389, 4576, 8136, 4344, 1788, 9875, 636, 1171, 5197, 3759, 1218, 4758, 8250, 6069, 9238, 5091, 2770, 7601, 6929, 6610, 3167, 6109, 129, 3594, 4755, 5240, 5845, 7577, 7510, 84, 4350, 9636, 9888, 8498, 8546, 4905, 7658, 7789, 3706, 6303, 942, 8176, 2777, 7253, 3256, 9390, 2687, 1435, 5512, 2463, 1779, 9548, 1316, 8948, 1545, 8226, 7825, 5729, 1392, 3261, 2442, 7677, 894, 2982, 6198, 422, 1967, 2228, 5081, 9822, 1548, 993, 8395, 4469, 6414, 5253, 937, 2879, 8806, 2793, 7173, 4794, 1626, 2053, 3202, 8171, 1970, 8696, 6989, 5606, 4024, 5247, 1853, 4365, 8901, 8424, 9569, 3640, 13, 2104, 6841, 9411, 4932, 4792, 7069, 1437, 5496, 2964, 91, 3389, 8835, 2944, 4254, 6095, 2402, 7156, 3710, 8696, 8904, 7589, 173, 4159, 6327, 7463, 9796, 9694, 2250, 2635, 8016, 4163, 7775, 4575, 200, 6206, 6552, 1596, 3334, 9827, 1724, 5914, 7529, 5565, 6465, 9186, 4947, 9227, 3674, 1163, 1954, 4869, 9822, 5146, 6833, 6949, 4676, 5597, 3602, 1093, 8663, 8294, 8234, 5486, 1948, 5056, 128, 7034, 4780, 4541, 5094, 7921, 453, 4760, 9775, 3783, 9663, 4491, 9469, 6249, 4666, 8695, 535, 2539, 7710, 2452, 599, 9878, 5251, 5688, 1629, 5143, 1800, 9523, 7806, 3093, 1325, 9048, 9648, 3990, 171, 3547, 6952, 9195, 7344, 2030, 471, 8827, 3852, 2572, 9733, 3778, 4827, 4418, 6827, 3595, 4651, 4878, 1554, 650, 1348, 7382, 50, 8205, 2375, 9675, 6261, 1080, 6486, 7519, 2431, 8735, 4792, 2523, 3604, 4467, 2082, 3221, 6029, 7154, 1433, 198, 7750, 5586, 2443, 4388, 5757, 3007, 9746, 8940, 1398, 6231, 7387, 2023, 8387, 881, 3837, 5802, 8709, 6980, 3905, 5837, 4257, 754, 1133, 9383, 5535, 1641, 2291, 7613, 5939, 2863, 8111, 7225, 4703, 8018, 8214, 5667, 6707, 7938, 8871, 923, 2860, 1832, 8388, 3776, 5228, 2503, 3223, 6695, 695, 3970, 3061, 524, 3893, 4701, 2425, 1928, 3082, 2688, 7777, 6658, 349, 7287, 3242, 8279, 6971, 7520, 2928, 1302, 6007, 13, 8863, 6906, 955, 718, 1150, 7125, 3756, 8127, 4480, 2939, 8226, 9995, 6797, 2400, 4289, 1914, 6924, 2381, 1073, 5596, 3102, 3788, 2075, 1603, 1976, 3699, 2118, 2196, 2767, 6703, 3313, 1005, 6147, 7696, 8101, 7283, 3547, 4383, 7074, 8186, 5810, 3620, 4384, 3770, 3041, 6538, 2541, 8491, 4043, 1362, 1391, 7253, 9046, 4504, 5690, 6719, 4559, 8333, 4267, 7238, 3277, 6957, 5970, 1106, 948, 4552, 4152, 7394, 9468, 1476, 9885, 4975, 8417, 4844, 9036, 7098, 7730, 5382, 5763, 6542, 2869, 4145, 8391, 57, 2960, 6296, 7481, 5159, 6965, 6804, 4290, 8551, 8498, 3630, 1184, 8560, 8936, 954, 6812, 4569, 3722, 6078, 2533, 5580, 6860, 5414, 4418, 41, 6634, 1891, 352, 5380, 5015, 1055, 1145, 8039, 1807, 1944, 5571, 9289, 6349, 9205, 6413, 4133, 5678, 1300, 1578, 565, 4559, 6156, 231, 11, 3799, 396, 3071, 3580, 5024, 5180, 5185, 9819, 8580, 1464, 3838, 9380, 7185, 8683, 6189, 9209, 6402, 2201, 8749, 9593, 4529, 3618, 8174, 9962, 5967, 84, 2842, 3501, 3111, 6628, 9354, 4293, 6117, 6389, 9339, 3938, 1088, 6141, 5528, 8747, 8848, 2692, 9580, 9355, 8705, 2786, 2866, 5985, 541, 9120, 6895, 1124, 1949, 1011, 3220, 7511, 7900, 860, 4679, 406, 608, 9703, 3330, 8148, 6449, 4631, 6536, 8236, 3369, 8293, 1235, 7104, 2658, 2549, 2068, 353, 9642, 3772, 7613, 1872, 4882, 9482, 5497, 854, 9930, 7271, 1252, 3720, 1128, 9831, 1910, 6797, 2396, 5256, 2374, 3454, 8173, 7912, 6879, 3403, 7490, 6899, 3457, 4570, 2968, 9526, 5618, 6251, 3577, 7996, 3173, 5086, 4398, 5361, 775, 1841, 7826, 1882, 9754, 5513, 7979, 7327, 8931, 8693, 4290, 316, 6287, 1503, 9659, 7885, 4139, 4689, 305, 7020, 3002, 591, 8045, 5130, 4060, 1837, 9901, 6520, 3172, 7096, 8093, 2782, 373, 1770, 8823, 5579, 8347, 9361, 9672, 5622, 4853, 4476, 1040, 774, 3777, 4271, 217, 1950, 5065, 4658, 7692, 1491, 7459, 3945, 3319, 5746, 15, 194, 190, 6647, 8904, 6370, 7769, 283, 1424, 7819, 9658, 1300, 9006, 7660, 6625, 6461, 66, 9534, 1830, 1631, 4138, 9352, 6036, 8803, 2601, 1414, 2898, 6089, 6526, 5308, 6629, 1128, 86, 9268, 753, 40, 542, 3087, 9290, 8449, 7724, 2944, 997, 721, 6737, 9365, 5257, 7306, 9608, 4888, 5591, 640, 4769, 726, 5293, 5115, 710, 1297, 8655, 2298, 695, 7419, 2144, 3520, 7241, 6606, 7382, 5482, 3063, 7290, 8553, 4644, 6147, 1063, 4537, 1292, 6844, 2793, 8426, 638, 9862, 4793, 9449, 4832, 5752, 4359, 9375, 5263, 7865, 6890, 2526, 2498, 7553, 8572, 1417, 5144, 6333, 9619, 3656, 2685, 1788, 928, 7394, 5215, 3674, 7245, 9695, 5141, 8289, 5498, 6989, 334, 2221, 8791, 3320, 5124, 5437, 1908, 3559, 7799, 6248, 2313, 1232, 1988, 6521, 7368, 5446, 1469, 6467, 6487, 5717, 4700, 7653, 6377, 751, 3073, 9273, 478, 934, 1061, 3752, 9656, 6538, 4195, 2605, 6009, 8868, 3797, 8794, 3578, 1099, 4917, 6929, 9967, 6373, 4679, 8850, 4174, 2674, 342, 8491, 2209, 7808, 4449, 8016, 3082, 688, 6227, 1076, 6225, 1803, 7564, 2317, 3222, 9605, 2034, 8233, 5627, 1195, 7252, 7491, 8129, 5598, 2549, 3850, 8368, 1878, 9012, 6277, 3325, 7427, 96, 6214, 1972, 2177, 3036, 1250, 3094, 3842, 2355, 4417, 2720, 7806, 810, 8096, 2017, 7537, 2724, 1960, 3613, 8018, 1872, 9191, 3021, 4318, 4798, 2702, 6154, 9098, 960, 5543, 5510, 4798, 5727, 5403, 2675, 5077, 8401, 2341, 7000, 3445, 5264, 6579, 4255, 6685, 1519, 4314, 8929, 1214, 8112, 7093, 5518, 3672, 5235, 6468, 934, 7201, 4093, 7336, 122, 9448, 2379, 7277, 6307, 1156, 4638, 5990, 7601, 1347, 8164, 4944, 9105, 6203, 4603, 2059, 1230, 1659, 4245, 268, 3286, 850, 8800, 171, 6183, 3447, 30, 2900, 6725, 6559, 4557, 815, 2304, 4662, 6297, 2680, 4277, 8740, 8674, 3384, 4228, 1189, 2789, 7734, 4153, 4179, 5539, 8701, 5739, 6106, 9110, 3398, 24, 8268, 8032, 5723, 8506, 9181, 8392, 4750, 3377, 1061, 4708, 9139, 6289, 1596, 6840, 3429, 7603, 2300, 7016, 6894, 6685, 7888, 9242, 537, 5676, 5237, 7648, 7864, 2129, 8799, 5465, 2307, 3149, 2639, 1558, 6587, 7987, 8139, 6963, 5171, 4599, 3970, 3187, 5819, 7367, 7061, 2324, 8303, 2921, 9377, 3051, 8639, 2216, 1746, 631, 5183, 8733, 1296, 6605, 7093, 9624, 8490, 237, 3340, 9202, 2580, 7587, 2478, 5225, 4805, 6313, 5776, 6980, 2586, 3997, 1876, 1416, 594, 8196, 6401, 4363, 2895, 3283, 2448, 6132, 7790, 7518, 3382, 9691, 3688, 5464, 3138, 3966, 6845, 7695, 5156, 7092, 4480, 1472, 5038, 6446, 8780, 3296, 2704, 1713, 1460, 3671, 2180, 4128, 674, 4456, 5869, 7129, 1870, 5792, 114, 1191, 3938, 3508, 3704, 7035, 7522, 9068, 9159, 5336, 889, 1652, 188, 2001, 2760, 414, 3128, 3403, 683, 7022, 3501, 2581, 7437, 8439, 6660, 8367, 3416, 9062, 2444, 6849, 9340, 5465, 9667, 8413, 4353, 1307, 967, 5238, 1421, 6733, 527, 8784, 6789, 2333, 1749, 8922, 885, 6858, 1430, 4583, 9458, 8265, 8643, 8711, 3575, 3692, 9180, 6742, 1174, 9033, 228, 3010, 4406, 1758, 2416, 2950, 6120, 1717, 5295, 8945, 1404, 618, 6696, 356, 901, 5374, 9901, 1883, 812, 5095, 6676, 1437, 3169, 529, 5259, 9803, 1216, 9680, 8701, 8842, 4483, 2227, 5730, 8310, 4194, 6840, 2365, 5620, 1872, 9419, 1605, 4079, 1773, 4548, 1304, 645, 2426, 6407, 3796, 327, 9707, 523, 7388, 8870, 1789, 870, 3912, 431, 6039, 5719, 3909, 101, 6301, 2537, 560, 6025, 5187, 7480, 9152, 9149, 3186, 3998, 6480, 6408, 1152, 8017, 2260, 2192, 4179, 162, 1690, 3860, 4931, 6054, 9324, 9740, 4144, 9159, 2752, 5567, 5748, 8403, 1053, 3516, 5557, 2865, 8523, 8765, 4324, 6372, 9208, 5740, 1807, 2206, 166, 1763, 7630, 4069, 9998, 254, 5676, 6297, 3744, 9380, 4237, 4864, 2240, 2959, 1219, 9530, 7037, 8665, 1314, 11, 8907, 7004, 6167, 1425, 2177, 7774, 6604, 7151, 9215, 4265, 52, 161, 3508, 5728, 9666, 4917, 4139, 6955, 840, 9766, 6232, 6037, 7121, 7692, 8801, 6884, 8344, 653, 9029, 7173, 752, 2141, 6474, 3987, 370, 9097, 5546, 6776, 7609, 5848, 5141, 663, 5770, 1640, 6523, 4202, 3720, 4103, 310, 3812, 6715, 6417, 4455, 4609, 9617, 5198, 3182, 5917, 3600, 7803, 3365, 7821, 6300, 1614, 5287, 8552, 2040, 3466, 2220, 871, 6409, 5387, 1801, 7376, 4326, 195, 91, 9827, 9618, 7399, 4494, 8764, 9210, 7616, 2192, 6925, 6916, 4187, 2549, 8621, 9885, 7526, 3152, 1959, 141, 6310, 8904, 5354, 9481, 4851, 4032, 355, 7642, 8046, 4734, 5069, 8127, 8563, 4658, 2625, 1022, 1504, 6979, 1608, 721, 4847, 241, 8397, 540, 7945, 824, 6332, 3367, 9425, 1199, 4959, 7341, 5375, 355, 6101, 2139, 8987, 6552, 9771, 3937, 1911, 8145, 4806, 3245, 915, 2400, 1712, 3436, 1005, 7957, 9403, 5083, 8841, 1581, 3255, 9465, 69, 9045, 8362, 4796, 2870, 5367, 3432, 3995, 938, 5688, 3828, 9069, 1132, 543, 7403, 7509, 5901, 8074, 3902, 5359, 6320, 8308, 8363, 3074, 7571, 8276, 328, 4912, 2703, 2536, 702, 2335, 4419, 7062, 8722, 9215, 9464, 7733, 9770, 6100, 6128, 1349, 4613, 3022, 6893, 135, 3326, 5412, 5981, 784, 9534, 2900, 3734, 5066, 2466, 4921, 2644, 2154, 6677, 5300, 1910, 123, 7057, 2699, 5868, 9093, 930, 6178, 5764, 6826, 3535, 4653, 3519, 4928, 1624, 1531, 7384, 3806, 2365, 9168, 1667, 7067, 7853, 6930, 2383, 7637, 6883, 9172, 1561, 6746, 8382, 4477, 2444, 4586, 2475, 4515, 8133, 9233, 4473, 9646, 6954, 6841, 2702, 4175, 846, 3517, 6362, 5335, 2878, 5849, 5104, 9378, 4104, 8020, 434, 5501, 6849, 4781, 6653, 2283, 7186, 655, 2410, 9379, 8270, 3406, 2229, 5947, 4884, 7167, 3387, 2234, 5748, 2309, 4230, 1940, 1577, 8614, 3691, 6804, 5472, 9142, 2883, 6562, 1675, 8206, 6729, 618, 3117, 1960, 3054, 3969, 3839, 1215, 2289, 2162, 741, 6550, 9842, 4783, 4529, 2564, 6403, 407, 9319, 5551, 2010, 7239, 3899, 6640, 9580, 5042, 8543, 4114, 7027, 4426, 2933, 2255, 3707, 917, 6242, 2507, 7164, 5564, 4483, 2513, 2287, 1194, 4436, 2030, 4791, 9201, 7929, 5708, 513, 9364, 1906, 4293, 4346, 7148, 6522, 908, 3538, 6540, 2498, 424, 3932, 3063, 8781, 1145, 3533, 4846, 6154, 1029, 8326, 6787, 9753, 464, 4260, 7399, 7290, 779, 219, 749, 1039, 3502, 9776, 9247, 5250, 3540, 4956, 2062, 8475, 9254, 5309, 6716, 2132, 9999, 4182, 3351, 4263, 1029, 7503, 1236, 2638, 4291, 9842, 8053, 1637, 7133, 1942, 9270, 1981, 4705, 2925, 2240, 9318, 7749, 5763, 6054, 8677, 8597, 5230, 923, 6833, 9618, 4270, 4889, 9543, 6181, 7555, 3651, 7123, 2604, 551, 2335, 2051, 7207, 9358, 3093, 647, 9538, 602, 4581, 2842, 8711, 3090, 6588, 8301, 5685, 2929, 8025, 7171, 7291, 9193, 2160, 4714, 5531, 7244, 3677, 9562, 1010, 4222, 3855, 8003, 727, 5724, 894, 2149, 5451, 648, 8247, 475, 9947, 1172, 426, 5725, 3019, 3586, 279, 1135, 6410, 3332, 4203, 4336, 4055, 1763, 5678, 5642, 4505, 3926, 5243, 4127, 7377, 1231, 9471, 1289, 82, 5566, 4372, 6821, 9264, 5920, 1732, 6688, 2501, 6328, 3374, 1967, 715, 3198, 7732, 2650, 3344, 1027, 7670, 5707, 7093, 834, 8751, 560, 6779, 5888, 9839, 5527, 3995, 3201, 1480, 8841, 1585, 83, 7301, 7654, 712, 1064, 9864, 1178, 1313, 2546, 2170, 6690, 9187, 6724, 7282, 3165, 4721, 2368, 3383, 2799, 8104, 9942, 3836, 9627, 465, 9761, 7151, 3813, 4872, 414, 1199, 7691, 2261, 4998, 5084, 3582, 6207, 6093, 390, 4159, 7930, 2571, 3863, 4867, 8781, 7159, 4174, 2281, 4869, 4802, 1684, 2083, 6880, 8526, 2729, 2592, 7617, 5254, 4743, 9849, 4905, 7154, 8497, 6575, 4898, 7251, 5000, 1592, 4736, 4615, 7647, 5098, 6887, 2824, 4979, 8715, 950, 2966, 8919, 6308, 8193, 5541, 3407, 1219, 2560, 5664, 5163, 392, 8596, 7631, 220, 9673, 5643, 393, 4784, 7041, 8244, 3500, 8056, 2905, 8990, 8670, 7712, 5838, 2164, 1627, 4597, 9553, 8695, 9701, 4391, 2644, 5907, 8547, 107, 3243, 620, 728, 7624, 1566, 1943, 9202, 4793, 8576, 6970, 9515, 1643, 2089, 2576, 4385, 5965, 9466, 9449, 9208, 7936, 310, 6235, 3414, 4873, 8170, 9211, 4795, 855, 6365, 7230, 1587, 6180, 9410, 2711, 1763, 5205, 9349, 4953, 607, 5251, 4179, 8086, 3332, 6683, 2003, 1362, 2045, 9279, 5209, 7318, 3947, 565, 489, 475, 3017, 9280, 7916, 5210, 3926, 9024, 5320, 3339, 7038, 3208, 2533, 4577, 8653, 7633, 2086, 1536, 2402, 7733, 6801, 8787, 4546, 4853, 6677, 1890, 6564, 9637, 8827, 3378, 1452, 6348, 9303, 3123, 3947, 9022, 3170, 5596, 1872, 2070, 1020, 9372, 9641, 8931, 5155, 4426, 9625, 7988, 3826, 7240, 7495, 262, 7640, 2282, 8088, 1335, 7044, 4542, 9821, 2991, 7230, 3643, 8248, 9094, 2893, 1878, 709, 3883, 1419, 2184, 6202, 5723, 4614, 7074, 8499, 8184, 6759, 2425, 4757, 1305, 393, 7078, 4529, 9240, 8576, 460, 1002, 5548, 9079, 1515, 8197, 5748, 9717, 5126, 3940, 2973, 1970, 3723, 2075, 7513, 998, 9193, 7526, 7637, 4723, 1225, 1970, 4909, 7591, 6097, 2740, 5, 1866, 868, 6612, 731, 6881, 3264, 3187, 8315, 609, 7800, 2853, 3331, 8565, 7844, 2636, 2079, 5514, 7526, 438, 2401, 8210, 7888, 2194, 8073, 9056, 5418, 8820, 1933, 775, 8861, 6095, 8979, 9376, 8011, 2859, 1763, 9525, 6716, 6423, 5186, 4007, 5754, 4670, 2849, 381, 1483, 8906, 6515, 7576, 1793, 3875, 7836, 7733, 888, 6069, 9662, 6172, 3138, 3021, 2511, 8766, 9535, 7992, 280, 8269, 1749, 2245, 6840, 820, 2110, 3995, 1566, 6589, 8787, 9425, 3858, 6779, 5425, 6490, 8363, 9549, 7018, 6803, 5807, 8806, 863, 9217, 6323, 1071, 6260, 619, 1841, 1165, 4034, 3137, 7125, 3799, 6530, 9812, 1631, 5156, 8412, 4231, 8348, 4727, 286, 8463, 418, 6259, 81, 5360, 9655, 6536, 6294, 8082, 2097, 2179, 5877, 834, 3913, 6361, 5387, 9723, 6226, 5442, 3579, 7363, 6236, 7224, 3036, 3058, 6704, 3362, 7355, 3286, 8978, 8477, 1373, 8542, 9623, 5023, 9110, 4064, 144, 6164, 98, 6012, 9216, 3201, 7530, 5677, 7400, 2659, 9094, 2845, 8982, 5083, 5224, 247, 3548, 2170, 110, 7732, 5962, 2126, 8070, 9771, 8151, 4501, 4408, 700, 7916, 6132, 9817, 6879, 602, 3500, 6815, 2609, 5992, 6102, 9619, 8610, 5157, 6889, 7312, 3956, 9802, 7682, 8027, 3411, 2988, 5064, 2941, 6655, 842, 6916, 59, 9427, 3424, 4063, 2436, 8179, 8882, 2043, 8411, 4724, 5726, 3228, 6545, 1399, 9146, 4808, 1975, 5291, 3725, 7576, 695, 8433, 5334, 3768, 1435, 9734, 3060, 137, 3594, 69, 6172, 5636, 3658, 4304, 7355, 2544, 6288, 3458, 7311, 2166, 8151, 6545, 8832, 4310, 316, 9110, 3098, 1629, 6655, 7120, 6944, 685, 839, 9475, 3296, 5825, 4033, 4511, 5221, 4656, 903, 4299, 5451, 1130, 4126, 1640, 5545, 1594, 5061, 6737, 9449, 8313, 9375, 6536, 3382, 3089, 9096, 6394, 6477, 2411, 7471, 2633, 5852, 3212, 9413, 9016, 4789, 5607, 5645, 5601, 6619, 2391, 9844, 7596, 3076, 8856, 3084, 5469, 4160, 2130, 9965, 7925, 1773, 6527, 8537, 7317, 5751, 4469, 814, 9663, 5649, 5187, 3551, 7581, 3040, 9555, 6946, 9288, 8675, 2516, 9523, 5573, 2192, 7445, 5343, 3698, 5478, 5102, 7271, 4146, 8285, 4673, 2307, 1075, 2619, 3652, 91, 6221, 3432, 5061, 6460, 3488, 3723, 7103, 7857, 2969, 1047, 6597, 4034, 230, 8117, 4931, 9131, 6223, 4695, 4903, 4942, 732, 5548, 9170, 158, 9521, 5229, 2321, 6729, 5024, 3443, 2247, 7792, 3601, 9997, 3301, 54, 8925, 327, 9698, 2116, 6434, 2339, 9083, 8109, 3748, 142, 1535, 7417, 9401, 777, 4200, 4393, 7672, 2199, 7849, 1979, 2375, 2951, 4396, 7990, 9635, 8632, 6416, 2072, 5695, 8794, 1473, 8573, 7917, 7520, 1502, 3726, 3434, 5447, 3034, 1964, 6776, 2082, 6333, 4807, 2969, 9271, 1218, 6271, 9204, 5697, 6398, 7884, 6898, 3292, 1750, 9696, 8956, 7322, 1535, 2651, 3773, 594, 4504, 7975, 4971, 8595, 3696, 2672, 6251, 7067, 6538, 9402, 6782, 5104, 5271, 9484, 1163, 861, 9249, 7126, 5584, 7325, 2262, 5432, 5383, 2818, 9665, 6941, 294, 725, 7491, 4147, 7672, 4, 1738, 26, 8285, 1598, 2280, 5999, 8291, 7221, 4690, 4241, 1172, 4291, 8004, 6599, 498, 4495, 3938, 7617, 7280, 6531, 8131, 2053, 7624, 3799, 3180, 19, 5451, 8870, 5415, 4240, 3565, 4715, 3095, 4110, 8975, 3900, 5108, 8745, 4850, 6606, 7371, 4699, 1495, 246, 2156, 8313, 4000, 902, 4225, 8918, 3676, 5183, 1113, 410, 4787, 6595, 1301, 7161, 4405, 2346, 7763, 1003, 8554, 278, 8958, 2348, 5516, 3694, 6473, 4124, 4676, 2183, 616, 8972, 1373, 7575, 2375, 3370, 4444, 3, 566, 285, 7696, 8067, 2742, 4675, 2350, 1669, 4932, 2430, 8775, 6276, 437, 7346, 9984, 3306, 5429, 604, 433, 2316, 6815, 1060, 6400, 5048, 5167, 8136, 413, 9335, 3289, 5285, 4893, 6911, 6736, 328, 8074, 7938, 9558, 401, 4793, 9381, 8347, 4843, 9613, 9417, 1789, 2883, 8234, 4044, 2070, 1290, 1892, 6327, 5313, 3970, 2890, 5055, 2127, 3151, 9397, 4990, 2987, 6895, 6446, 3605, 5916, 479, 6389, 2146, 5408, 4273, 7194, 2157, 4786, 1741, 3847, 6632, 6861, 1208, 8237, 9823, 4533, 1539, 1079, 5873, 4803, 2106, 8608, 5617, 2434, 5434, 8638, 1562, 3954, 989, 427, 3587, 1291, 1753, 5872, 5675, 9881, 9495, 8599, 9886, 493, 1569, 4299, 2643, 7684, 7164, 8376, 8941, 5670, 931, 1207, 5694, 9928, 6369, 9396, 812, 4602, 9618, 154, 4935, 5498, 4992, 4320, 7901, 2236, 4586, 9255, 4160, 9561, 6368, 7211, 2471, 9145, 2159, 5262, 2525, 9449, 9082, 1314, 6203, 3427, 4718, 1969, 7934, 7278, 7610, 8000, 6180, 1443, 5186, 1173, 210, 1241, 6879, 8451, 4609, 4384, 1669, 7636, 926, 7556, 9715, 3877, 3740, 6674, 887, 1085, 3000, 3699, 3586, 2931, 6585, 7228, 1385, 9626, 6621, 5635, 4500, 4181, 4684, 5967, 1552, 6463, 9680, 2794, 1362, 9306, 5277, 1605, 9413, 8279, 3997, 7748, 2893, 2658, 6630, 8187, 5976, 1652, 224, 8052, 4440, 894, 2070, 8464, 644, 9623, 7991, 727, 6087, 2717, 2727, 630, 1921, 3387, 1507, 7384, 8275, 2651, 6890, 6300, 70, 2329, 9464, 8754, 4495, 1805, 7786, 8841, 9090, 3219, 8722, 3939, 6330, 2973, 8537, 5832, 9296, 699, 9218, 6437, 8302, 4382, 2926, 8037, 1861, 1628, 3428, 1508, 7941, 5185, 3443, 1071, 2898, 1256, 6658, 9450, 4636, 9097, 179, 1709, 4087, 9549, 3540, 5094, 5029, 8266, 1958, 6949, 331, 1871, 5534, 2694, 9585, 2733, 9407, 6406, 8865, 1914, 2762, 9470, 1469, 7771, 6685, 4087, 8524, 8153, 8634, 2853, 3499, 1293, 8404, 9566, 8140, 5772, 8800, 1203, 8508, 5896, 4748, 5077, 2778, 5630, 734, 2443, 5109, 7683, 2027, 3182, 3973, 3540, 2327, 5381, 6574, 2648, 6919, 440, 3701, 4496, 8493, 8136, 9256, 426, 5473, 6575, 7617, 7327, 5683, 6099, 5275, 7837, 8671, 7619, 9377, 2957, 2892, 2670, 2032, 7923, 6202, 8627, 1390, 7960, 6548, 3706, 7762, 160, 1981, 6673, 6479, 9865, 5532, 7934, 6643, 1370, 5859, 7150, 131, 8298, 4810, 2245, 7769, 2500, 4790, 7623, 5672, 4239, 9397, 4418, 1518, 711, 1428, 7555, 4505, 8352, 6188, 6243, 4510, 4629, 683, 3749, 3171, 2735, 7968, 4254, 3360, 1545, 7027, 5354, 1764, 1023, 6330, 6936, 4701, 3268, 3859, 8640, 1134, 3893, 9216, 4113, 2449, 3063, 3123, 2319, 4923, 4056, 4152, 8643, 6614, 4233, 6712, 7812, 9609, 8428, 1800, 1186, 5552, 1488, 4951, 3032, 7832, 4913, 5414, 2999, 7393, 8485, 2535, 2092, 9565, 9607, 9499, 6428, 4529, 3990, 4854, 7931, 3285, 733, 287, 833, 5298, 529, 9415, 6937, 966, 6558, 7346, 3035, 8710, 1018, 73, 803, 155, 2566, 5091, 742, 6335, 1827, 625, 6899, 5317, 4709, 2564, 9222, 8440, 1217, 9168, 170, 2677, 6077, 4499, 6093, 8890, 9256, 6730, 6709, 7295, 5939, 7522, 5374, 9541, 6840, 2048, 9814, 8690, 9739, 2162, 1184, 5003, 1288, 3552, 2152, 5711, 4939, 5551, 9622, 9506, 8581, 1103, 9585, 585, 3674, 5910, 3090, 3259, 3791, 1926, 2078, 6899, 6648, 4463, 2070, 1198, 7795, 1282, 4203, 532, 2583, 3142, 9290, 2116, 8636, 7695, 741, 6860, 6539, 584, 5354, 3509, 1934, 6429, 3629, 729, 434, 9682, 5247, 9814, 84, 9893, 3719, 1958, 8331, 6913, 239, 168, 1975, 2220, 4341, 425, 3980, 2033, 234, 8345, 3203, 8507, 1480, 7606, 1155, 6748, 3405, 4206, 5945, 2073, 945, 6065, 2882, 1395, 9318, 4124, 21, 6799, 4389, 5425, 1001, 1813, 3173, 9096, 1385, 675, 9117, 1010, 913, 7400, 3339, 1446, 568, 3329, 7557, 7386, 699, 3517, 1911, 4904, 8882, 4566, 6410, 4017, 672, 7231, 552, 8350, 4984, 7313, 4672, 2209, 6134, 4626, 1666, 9131, 8146, 236, 6291, 2214, 5478, 4818, 1346, 1418, 2766, 7577, 9460, 2991, 9923, 8352, 9927, 4835, 5296, 5613, 144, 2086, 4902, 7557, 7147, 8434, 6385, 8506, 8413, 515, 5390, 720, 6817, 7402, 8461, 5914, 5878, 8496, 5111, 1938, 6075, 3628, 5826, 2350, 4322, 4825, 9761, 7845, 5014, 3715, 5480, 8470, 9344, 4885, 4812, 494, 4730, 6062, 5377, 6970, 2959, 1461, 4402, 2877, 3161, 4543, 9523, 4000, 6221, 1592, 2476, 3662, 5391, 2996, 5085, 7946, 4033, 8245, 5993, 72, 4971, 3680, 5214, 7908, 8251, 8756, 2708, 8977, 6442, 4349, 4587, 5210, 2699, 613, 9795, 4664, 2965, 6548, 8086, 4728, 9220, 44, 9422, 3355, 7595, 7425, 1543, 5779, 4025, 2862, 3774, 8263, 403, 4910, 2558, 8654, 6362, 2813, 5515, 5076, 9075, 5194, 399, 7107, 2720, 9011, 6677, 9595, 174, 5425, 180, 9216, 6802, 4328, 5233, 1817, 8294, 6422, 2728, 8952, 1245, 7078, 4318, 3696, 959, 2330, 2313, 8906, 9492, 1539, 7102, 6518, 4747, 4065, 3798, 7031, 4534, 2961, 7029, 2922, 8693, 2067, 4251, 5769, 804, 2274, 59, 6366, 689, 5778, 4198, 6041, 5437, 1197, 8793, 8541, 9638, 5378, 650, 2171, 5197, 3179, 3311, 4411, 8237, 4595, 9339, 9526, 9351, 5074, 3597, 4112, 5925, 3370, 2443, 6891, 5733, 4672, 2554, 7505, 448, 274, 9094, 6945, 4635, 34, 2999, 2817, 420, 3435, 3663, 1935, 5685, 1289, 8271, 6583, 3900, 5957, 3068, 4097, 1746, 5948, 8817, 303, 9828, 4684, 8233, 4287, 6369, 2280, 4254, 7295, 2278, 5449, 6157, 2555, 522, 2552, 2577, 3937, 3971, 4216, 7487, 2885, 4913, 3218, 2679, 5153, 9859, 609, 8392, 2951, 9993, 988, 3358, 117, 9230, 1161, 3033, 1509, 4414, 253, 2336, 265, 7639, 6950, 3148, 8758, 9268, 7408, 4071, 7553, 40, 3358, 67, 7390, 4160, 3498, 1955, 5155, 7709, 3878, 7568, 5943, 4491, 3788, 9978, 5965, 8173, 8971, 9245, 798, 2445, 3095, 6279, 6511, 2570, 3508, 3964, 1071, 2882, 9165, 4174, 2142, 5924, 5624, 2199, 9793, 5434, 8321, 7936, 8694, 2694, 5724, 214, 993, 7243, 3168, 6434, 1896, 493, 3526, 3647, 4180, 2605, 353, 2549, 8775, 1309, 9292, 5392, 545, 8469, 8047, 4314, 3378, 7842, 4858, 2850, 9675, 3242, 9691, 3719, 1436, 2616, 407, 1942, 1279, 7182, 5118, 3535, 3534, 1431, 4639, 5450, 6071, 9438, 1919, 7038, 5926, 9001, 1024, 3957, 4093, 4544, 6169, 4477, 2185, 3332, 3417, 9807, 4250, 425, 8854, 1051, 3740, 7027, 7224, 6383, 9932, 1005, 6226, 6227, 3273, 9702, 1425, 9133, 1462, 6694, 5342, 6088, 8185, 2698, 2255, 8681, 1165, 8807, 9884, 8626, 5715, 3421, 2748, 8609, 6801, 1549, 8526, 9091, 2777, 2072, 5754, 3934, 910, 5781, 7704, 1335, 6289, 1358, 9156, 123, 195, 221, 2234, 9660, 3640, 4690, 3871, 9573, 6383, 6683, 8644, 5515, 4958, 569, 7006, 1897, 1857, 8745, 5895, 141, 4419, 6283, 2429, 2836, 2843, 5539, 9650, 4095, 3440, 5249, 8469, 6547, 5668, 997, 874, 9374, 2477, 463, 1633, 1490, 9234, 9387, 7516, 5087, 6392, 9903, 4972, 9722, 1938, 1269, 6178, 7085, 6552, 2028, 5786, 1649, 4202, 2203, 3591, 3924, 6034, 7409, 4776, 7150, 1490, 1893, 1368, 272, 9131, 8548, 9885, 9577, 7280, 85, 648, 1820, 8094, 8062, 5891, 8501, 8774, 3589, 1954, 7263, 8751, 4147, 8544, 7914, 6164, 8346, 6222, 1968, 5812, 444, 4174, 1968, 3143, 8692, 1090, 882, 3201, 4049, 6157, 146, 2907, 1170, 6058, 6634, 9914, 5770, 5152, 9975, 3242, 1302, 5547, 635, 7492, 8525, 1174, 8749, 2806, 8742, 24, 95, 6911, 4555, 5752, 7289, 2034, 3131, 4243, 1852, 7865, 2968, 1101, 1697, 5943, 9465, 7866, 3898, 5980, 9460, 5240, 941, 6780, 2549, 6306, 7977, 1155, 717, 2360, 2028, 7691, 14, 4124, 8740, 9700, 7895, 9637, 5926, 9888, 5160, 9734, 6347, 4304, 6042, 3260, 4123, 2672, 857, 1235, 4802, 8743, 241, 6210, 6843, 9701, 1425, 5298, 3940, 3132, 3085, 2574, 4486, 8781, 5351, 6924, 6197, 2531, 279, 1087, 4484, 4333, 6997, 548, 3701, 8864, 7305, 2190, 9595, 6090, 8375, 8406, 5577, 7055, 7164, 820, 982, 5229, 5237, 1157, 8039, 4845, 6850, 1078, 3174, 4379, 9554, 3869, 1084, 8117, 4062, 1539, 2844, 101, 3692, 6657, 1266, 8082, 169, 4457, 2642, 16, 7981, 48, 9090, 7457, 798, 7703, 1457, 890, 4077, 5365, 5559, 7986, 2895, 6057, 6509, 5578, 4331, 3021, 1313, 4383, 3298, 3489, 649, 4678, 9578, 2221, 5837, 9595, 117, 4607, 6746, 2784, 423, 1601, 7893, 5967, 4000, 4489, 2482, 7791, 3999, 7289, 6717, 7811, 2914, 3224, 7396, 5125, 1004, 197, 4954, 9663, 6780, 3343, 2517, 3788, 7553, 3323, 5274, 1337, 4529, 7300, 4520, 1162, 3509, 5626, 4970, 2, 9686, 3167, 9079, 9070, 8866, 9250, 3077, 1346, 5117, 9832, 8247, 8008, 190, 7872, 5876, 9428, 2545, 5798, 9361, 9376, 8695, 8035, 4203, 5868, 5321, 9516, 6135, 7212, 5469, 6551, 8584, 2351, 4441, 3277, 2591, 1083, 9498, 3846, 9406, 3054, 6407, 8947, 1045, 8348, 7063, 504, 7739, 5355, 9546, 3931, 2011, 7580, 6413, 462, 6823, 7235, 3189, 5187, 7179, 3856, 5955, 1728, 3510, 4415, 2851, 8260, 2115, 7945, 9284, 9387, 5535, 9061, 5290, 8740, 1382, 9217, 6468, 1834, 1470, 231, 1938, 5111, 9900, 8495, 5011, 5708, 6590, 6342, 3666, 9832, 9874, 3676, 6724, 496, 4933, 823, 1872, 9659, 6909, 4701, 5164, 9029, 9582, 7648, 1673, 1394, 1670, 993, 6646, 817, 3985, 8599, 6477, 8020, 5048, 5583, 7916, 4214, 3571, 1358, 9784, 6315, 7853, 1236, 6026, 1343, 365, 5532, 1291, 7106, 7558, 4948, 5074, 7970, 5483, 4823, 6417, 1936, 5085, 5106, 7527, 8337, 3290, 3999, 7080, 7088, 2961, 7695, 9591, 930, 1796, 3293, 7500, 1921, 6573, 1789, 9810, 1534, 8162, 6995, 7156, 7835, 7554, 9852, 311, 4033, 7145, 5718, 4144, 1886, 3718, 6787, 2493, 7357, 2341, 419, 4202, 8986, 6019, 1949, 7228, 8993, 5018, 2994, 3984, 9961, 8722, 8982, 9375, 3716, 174, 757, 3161, 4470, 3010, 6111, 9016, 1772, 4199, 1322, 6410, 8441, 6007, 6835, 3985, 6464, 1074, 2, 2003, 4576, 8105, 9149, 4165, 8523, 4695, 2843, 5951, 1850, 9721, 7283, 8959, 3929, 9634, 3995, 3124, 3593, 8234, 5404, 2086, 1819, 4598, 1042, 8016, 3840, 6205, 8104, 1075, 8325, 5326, 9097, 742, 1415, 9542, 366, 1379, 301, 4620, 9461, 9907, 2874, 5330, 7699, 2302, 5080, 7792, 1158, 3890, 6650, 2917, 8543, 6355, 3467, 4654, 5520, 4120, 4142, 6506, 3204, 5146, 9955, 5823, 7939, 3624, 8163, 8836, 6626, 5539, 9082, 7848, 9469, 1455, 7443, 3573, 9999, 7587, 2471, 3226, 5266, 4330, 9570, 6169, 8085, 6347, 3996, 1386, 5294, 5392, 5123, 5484, 7477, 1600, 9326, 5135, 2618, 789, 4495, 8080, 1666, 2608, 4698, 3388, 789, 8902, 8148, 1451, 8415, 2968, 4979, 7853, 7397, 2640, 1962, 4639, 6786, 4503, 9521, 6524, 5110, 9745, 9075, 6363, 2991, 5262, 1256, 4921, 5151, 3331, 9649, 78, 1445, 9376, 4471, 4532, 7944, 3899, 2379, 574, 2750, 5186, 931, 8071, 3185, 4265, 4607, 8555, 7668, 1392, 6285, 7235, 1981, 7555, 565, 4711, 4850, 5667, 4386, 6537, 5474, 3853, 7895, 8370, 8109, 9705, 7034, 3762, 2125, 4908, 3156, 7274, 7264, 8990, 2695, 4730, 505, 4562, 8975, 4979, 5934, 5964, 3641, 5671, 7864, 7963, 4744, 1950, 3693, 9143, 3071, 5517, 965, 5616, 5298, 5140, 2639, 3563, 2292, 685, 9258, 4270, 2472, 1746, 7632, 9332, 2292, 7931, 1750, 5878, 9493, 9527, 935, 6972, 1891, 7968, 670, 1919, 8274, 3499, 8502, 1469, 5673, 8775, 9854, 9440, 6199, 3025, 3144, 169, 7399, 92, 1015, 5063, 7106, 8569, 4500, 5872, 4756, 3174, 76, 522, 2556, 1438, 4842, 9593, 621, 3628, 2712, 2179, 5215, 7546, 9500, 4877, 8922, 2754, 2607, 1590, 1760, 9851, 3548, 1899, 5633, 2232, 8844, 6762, 5536, 729, 3590, 4589, 4404, 8750, 5480, 6914, 7479, 9877, 8089, 7515, 7726, 7463, 475, 3041, 3461, 2845, 2544, 212, 9768, 5033, 8711, 3617, 7129, 5190, 2605, 7341, 7135, 7267, 3033, 3200, 6277, 4089, 3438, 5304, 9415, 6531, 9053, 7807, 4090, 9260, 8282, 1149, 6355, 1094, 6767, 650, 4735, 4840, 8872, 7731, 9308, 6982, 7844, 1545, 5913, 7606, 3124, 9188, 6735, 6138, 1360, 5133, 3506, 5468, 3006, 1910, 76, 6472, 6652, 8441, 7909, 4204, 3356, 4650, 7938, 4643, 2689, 1094, 6016, 1772, 9987, 8772, 2387, 9530, 8674, 8606, 8124, 4015, 89, 2583, 9845, 1288, 6773, 3133, 940, 5191, 1573, 7378, 6124, 9718, 9387, 85, 774, 6374, 1900, 1504, 9279, 1852, 4735, 4908, 4983, 8070, 3249, 2197, 7628, 9068, 9728, 9673, 8015, 9389, 1824, 4634, 7202, 8986, 8899, 7249, 350, 1271, 7703, 3097, 3391, 853, 3126, 8213, 4208, 2991, 970, 3869, 275, 9424, 3362, 5854, 4375, 4877, 248, 8740, 4879, 5121, 4601, 8226, 5623, 6700, 6046, 1010, 3591, 9966, 4051, 2316, 4587, 3214, 5041, 3487, 2508, 4523, 3625, 10, 1381, 7151, 6044, 3518, 6393, 6566, 8550, 1437, 9845, 6760, 8074, 3604, 4809, 781, 7327, 4109, 535, 4123, 2276, 6096, 3014, 6548, 75, 766, 9053, 1757, 5293, 3846, 2385, 8178, 3216, 5527, 6186, 9042, 3228, 201, 4030, 7060, 8285, 8419, 3774, 4658, 851, 1616, 1879, 554, 5856, 5876, 6235, 648, 6465, 7856, 8899, 8226, 6279, 828, 7794, 5892, 476, 8734, 7496, 7699, 9654, 2228, 4738, 2920, 6761, 1917, 343, 9612, 3139, 9992, 134, 8349, 7413, 3844, 1136, 9487, 2662, 2675, 7459, 3560, 6925, 9100, 5009, 9180, 4404, 6563, 5779, 2936, 8480, 6633, 4652, 527, 3989, 4571, 7336, 1511, 1799, 1581, 9421, 5921, 8230, 9570, 9056, 6452, 6137, 1850, 8860, 7543, 6548, 2271, 141, 5120, 2797, 529, 1744, 2921, 6236, 6230, 5181, 3965, 2033, 1976, 5051, 6327, 5334, 7217, 7947, 2225, 7187, 2978, 9134, 2412, 284, 2461, 8900, 4227, 9676, 8642, 1080, 3006, 4120, 9464, 9596, 3434, 5622, 5330, 1819, 2157, 2189, 1499, 3382, 2806, 7234, 6828, 9972, 4062, 5109, 9485, 6226, 3556, 4730, 3540, 1986, 9637, 1528, 5052, 6359, 2979, 5595, 5931, 5028, 858, 7571, 3129, 2050, 6539, 1822, 1218, 5599, 6925, 9882, 5793, 7069, 8698, 1721, 6136, 9481, 6998, 2907, 7364, 1817, 7289, 9079, 5585, 6124, 2644, 3978, 5665, 7098, 2269, 9247, 9396, 7370, 8380, 4391, 8181, 8703, 7110, 3481, 6513, 9243, 2565, 1670, 8909, 3746, 932, 7244, 8798, 7789, 4456, 1915, 126, 7531, 6998, 5202, 8643, 9126, 2132, 5584, 3535, 2816, 495, 9703, 3286, 8104, 752, 2572, 7401, 6255, 6061, 9931, 6950, 9160, 8860, 5233, 6693, 9167, 4630, 6008, 5375, 8294, 6457, 290, 5716, 7953, 8163, 4724, 1949, 2294, 182, 8594, 9127, 1965, 9405, 6931, 5830, 9069, 2877, 9878, 6423, 3509, 6475, 7592, 9506, 221, 7715, 6570, 8061, 1263, 9927, 2537, 988, 5681, 9925, 4337, 986, 5564, 9612, 1517, 516, 6085, 6284, 8602, 8378, 9363, 563, 2389, 7111, 5672, 5254, 6145, 4901, 1369, 9901, 1939, 3690, 6270, 6009, 3782, 2442, 7196, 1507, 9079, 8120, 4639, 8981, 8557, 9291, 3862, 3151, 8875, 7027, 2873, 1536, 482, 1711, 366, 407, 8097, 770, 4618, 7430, 9923, 5422, 8777, 8947, 3910, 1073, 1619, 7567, 1553, 3678, 6333, 8811, 7616, 5209, 4618, 8793, 5417, 3434, 1206, 4061, 3066, 8146, 2691, 2715, 5908, 4549, 2329, 4088, 7408, 4458, 7489, 7568, 9980, 7967, 3903, 2399, 6591, 2614, 6359, 8779, 4236, 6298, 72, 2059, 2418, 7242, 1930, 8461, 3485, 5009, 7759, 9923, 8922, 7203, 3950, 8462, 7197, 3153, 1761, 2737, 3754, 2773, 5023, 4228, 1750, 8974, 9143, 4971, 470, 8727, 4657, 92, 8761, 1168, 1429, 4280, 2010, 9153, 2915, 3751, 4669, 4994, 6123, 3214, 5992, 374, 2691, 2464, 4503, 4238, 3016, 9580, 7616, 7497, 2027, 9296, 6104, 339, 8797, 6653, 4415, 509, 3697, 9652, 7399, 5952, 359, 6876, 8808, 6792, 4169, 4796, 8249, 7641, 5375, 5016, 2613, 9662, 3640, 843, 5376, 7576, 404, 3158, 4131, 4575, 8859, 519, 505, 3056, 26, 8146, 4307, 5786, 2924, 1314, 1247, 419, 4531, 9582, 4367, 5567, 7871, 4706, 4781, 2851, 303, 6787, 7190, 1859, 6099, 2089, 4934, 804, 7305, 9340, 481, 6152, 1047, 3357, 4516, 7540, 1400, 7597, 6184, 5520, 76, 9170, 9313, 1273, 4840, 38, 6714, 1581, 1883, 5969, 257, 4618, 576, 3380, 2586, 2765, 2638, 4032];
-    let mut arr = numbers.to_vec();
+    let size: usize = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+    let seed: u32 = param(2, "BENCH_SEED", DEFAULT_SEED);
+
+    let start = Instant::now();
+
+    let mut arr = generate(size, seed);
     bubble_sort(&mut arr);
+    println!("size={} seed={} checksum={}", size, seed, checksum(&arr));
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
 }
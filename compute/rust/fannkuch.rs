@@ -0,0 +1,79 @@
+use std::env;
+use std::time::Instant;
+
+const DEFAULT_SIZE: usize = 10;
+
+/// Checksum and maximum number of flips over all permutations of n
+/// elements, visited in the Benchmarks Game order.
+fn fannkuch(n: usize) -> (i64, i32) {
+    let mut perm = vec![0usize; n];
+    let mut perm1: Vec<usize> = (0..n).collect();
+    let mut count = vec![0usize; n];
+
+    let (mut checksum, mut max_flips, mut perm_count) = (0i64, 0i32, 0u64);
+    let mut r = n;
+    loop {
+        while r != 1 {
+            count[r - 1] = r;
+            r -= 1;
+        }
+
+        perm.copy_from_slice(&perm1);
+        let mut flips = 0;
+        let mut k = perm[0];
+        while k != 0 {
+            perm[..=k].reverse();
+            flips += 1;
+            k = perm[0];
+        }
+        max_flips = max_flips.max(flips);
+        if perm_count % 2 == 0 {
+            checksum += flips as i64;
+        } else {
+            checksum -= flips as i64;
+        }
+
+        // Advance to the next permutation
+        loop {
+            if r == n {
+                return (checksum, max_flips);
+            }
+            let perm0 = perm1[0];
+            for i in 0..r {
+                perm1[i] = perm1[i + 1];
+            }
+            perm1[r] = perm0;
+            count[r] -= 1;
+            if count[r] > 0 {
+                break;
+            }
+            r += 1;
+        }
+        perm_count += 1;
+    }
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let n: usize = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    let start = Instant::now();
+
+    let (checksum, max_flips) = fannkuch(n);
+    println!("{}\nPfannkuchen({}) = {}", checksum, n, max_flips);
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
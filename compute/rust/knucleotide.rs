@@ -0,0 +1,91 @@
+use std::collections::HashMap;
+use std::env;
+use std::time::Instant;
+
+const DEFAULT_SIZE: usize = 1000000;
+const DEFAULT_SEED: u32 = 42;
+
+// Linear congruential generator used by the Benchmarks Game fasta program
+const IM: u32 = 139968;
+const IA: u32 = 3877;
+const IC: u32 = 29573;
+
+/// Splits [0, IM) into a, c, g and t with the fasta homo sapiens
+/// frequencies, as integers so every language picks the same nucleotides.
+const THRESHOLDS: [u32; 3] = [42404, 70116, 97766];
+
+/// DNA sequence of the given length.
+fn generate(size: usize, seed: u32) -> Vec<u8> {
+    let nucleotides = b"ACGT";
+    let mut last = seed % IM;
+    (0..size)
+        .map(|_| {
+            last = (last * IA + IC) % IM;
+            let k = THRESHOLDS.iter().position(|&t| last < t).unwrap_or(3);
+            nucleotides[k]
+        })
+        .collect()
+}
+
+/// Counts every substring of length k.
+fn frequencies(seq: &[u8], k: usize) -> HashMap<&[u8], usize> {
+    let mut counts = HashMap::new();
+    for window in seq.windows(k) {
+        *counts.entry(window).or_insert(0) += 1;
+    }
+    counts
+}
+
+/// K-mer frequencies in percent, most frequent first and ties broken
+/// alphabetically.
+fn sorted_frequencies(seq: &[u8], k: usize) -> String {
+    let counts = frequencies(seq, k);
+    let total: usize = counts.values().sum();
+    let mut entries: Vec<_> = counts.into_iter().collect();
+    entries.sort_by(|a, b| b.1.cmp(&a.1).then(a.0.cmp(b.0)));
+
+    let mut out = String::new();
+    for (key, n) in entries {
+        out += &format!(
+            "{} {:.3}\n",
+            String::from_utf8_lossy(key),
+            100.0 * n as f64 / total as f64
+        );
+    }
+    out
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let size: usize = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+    let seed: u32 = param(2, "BENCH_SEED", DEFAULT_SEED);
+
+    let start = Instant::now();
+
+    let seq = generate(size, seed);
+
+    println!("{}", sorted_frequencies(&seq, 1));
+    println!("{}", sorted_frequencies(&seq, 2));
+    for fragment in ["GGT", "GGTA", "GGTATT", "GGTATTTTAATT", "GGTATTTTAATTTATAGT"] {
+        let count = frequencies(&seq, fragment.len())
+            .get(fragment.as_bytes())
+            .copied()
+            .unwrap_or(0);
+        println!("{}\t{}", count, fragment);
+    }
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
@@ -0,0 +1,147 @@
+use std::env;
+use std::time::Instant;
+use std::f64::consts::PI;
+
+const DEFAULT_SIZE: usize = 1000000;
+
+const SOLAR_MASS: f64 = 4.0 * PI * PI;
+const DAYS_PER_YEAR: f64 = 365.24;
+
+#[derive(Clone, Copy)]
+struct Body {
+    x: f64,
+    y: f64,
+    z: f64,
+    vx: f64,
+    vy: f64,
+    vz: f64,
+    mass: f64,
+}
+
+fn new_system() -> Vec<Body> {
+    let mut bodies = vec![
+        // Sun
+        Body { x: 0.0, y: 0.0, z: 0.0, vx: 0.0, vy: 0.0, vz: 0.0, mass: SOLAR_MASS },
+        // Jupiter
+        Body {
+            x: 4.84143144246472090e+00,
+            y: -1.16032004402742839e+00,
+            z: -1.03622044471123109e-01,
+            vx: 1.66007664274403694e-03 * DAYS_PER_YEAR,
+            vy: 7.69901118419740425e-03 * DAYS_PER_YEAR,
+            vz: -6.90460016972063023e-05 * DAYS_PER_YEAR,
+            mass: 9.54791938424326609e-04 * SOLAR_MASS,
+        },
+        // Saturn
+        Body {
+            x: 8.34336671824457987e+00,
+            y: 4.12479856412430479e+00,
+            z: -4.03523417114321381e-01,
+            vx: -2.76742510726862411e-03 * DAYS_PER_YEAR,
+            vy: 4.99852801234917238e-03 * DAYS_PER_YEAR,
+            vz: 2.30417297573763929e-05 * DAYS_PER_YEAR,
+            mass: 2.85885980666130812e-04 * SOLAR_MASS,
+        },
+        // Uranus
+        Body {
+            x: 1.28943695621391310e+01,
+            y: -1.51111514016986312e+01,
+            z: -2.23307578892655734e-01,
+            vx: 2.96460137564761618e-03 * DAYS_PER_YEAR,
+            vy: 2.37847173959480950e-03 * DAYS_PER_YEAR,
+            vz: -2.96589568540237556e-05 * DAYS_PER_YEAR,
+            mass: 4.36624404335156298e-05 * SOLAR_MASS,
+        },
+        // Neptune
+        Body {
+            x: 1.53796971148509165e+01,
+            y: -2.59193146099879641e+01,
+            z: 1.79258772950371181e-01,
+            vx: 2.68067772490389322e-03 * DAYS_PER_YEAR,
+            vy: 1.62824170038242295e-03 * DAYS_PER_YEAR,
+            vz: -9.51592254519715870e-05 * DAYS_PER_YEAR,
+            mass: 5.15138902046611451e-05 * SOLAR_MASS,
+        },
+    ];
+
+    // Offset the sun's momentum so the system's total momentum is zero
+    let (mut px, mut py, mut pz) = (0.0, 0.0, 0.0);
+    for b in &bodies {
+        px += b.vx * b.mass;
+        py += b.vy * b.mass;
+        pz += b.vz * b.mass;
+    }
+    bodies[0].vx = -px / SOLAR_MASS;
+    bodies[0].vy = -py / SOLAR_MASS;
+    bodies[0].vz = -pz / SOLAR_MASS;
+    bodies
+}
+
+fn advance(bodies: &mut [Body], dt: f64) {
+    for i in 0..bodies.len() {
+        let (head, tail) = bodies.split_at_mut(i + 1);
+        let bi = &mut head[i];
+        for bj in tail.iter_mut() {
+            let dx = bi.x - bj.x;
+            let dy = bi.y - bj.y;
+            let dz = bi.z - bj.z;
+            let d_squared = dx * dx + dy * dy + dz * dz;
+            let distance = d_squared.sqrt();
+            let mag = dt / (d_squared * distance);
+            bi.vx -= dx * bj.mass * mag;
+            bi.vy -= dy * bj.mass * mag;
+            bi.vz -= dz * bj.mass * mag;
+            bj.vx += dx * bi.mass * mag;
+            bj.vy += dy * bi.mass * mag;
+            bj.vz += dz * bi.mass * mag;
+        }
+    }
+    for b in bodies.iter_mut() {
+        b.x += dt * b.vx;
+        b.y += dt * b.vy;
+        b.z += dt * b.vz;
+    }
+}
+
+fn energy(bodies: &[Body]) -> f64 {
+    let mut e = 0.0;
+    for (i, bi) in bodies.iter().enumerate() {
+        e += 0.5 * bi.mass * (bi.vx * bi.vx + bi.vy * bi.vy + bi.vz * bi.vz);
+        for bj in &bodies[i + 1..] {
+            let dx = bi.x - bj.x;
+            let dy = bi.y - bj.y;
+            let dz = bi.z - bj.z;
+            e -= bi.mass * bj.mass / (dx * dx + dy * dy + dz * dz).sqrt();
+        }
+    }
+    e
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let steps: usize = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    let start = Instant::now();
+
+    let mut bodies = new_system();
+    println!("{:.9}", energy(&bodies));
+    for _ in 0..steps {
+        advance(&mut bodies, 0.01);
+    }
+    println!("{:.9}", energy(&bodies));
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
@@ -0,0 +1,71 @@
+use std::env;
+use std::time::Instant;
+
+const DEFAULT_SIZE: usize = 1000;
+
+/// Entry (i, j) of the infinite matrix A.
+fn a(i: usize, j: usize) -> f64 {
+    1.0 / ((i + j) * (i + j + 1) / 2 + i + 1) as f64
+}
+
+fn multiply_av(v: &[f64], av: &mut [f64]) {
+    for (i, out) in av.iter_mut().enumerate() {
+        let mut sum = 0.0;
+        for (j, x) in v.iter().enumerate() {
+            sum += a(i, j) * x;
+        }
+        *out = sum;
+    }
+}
+
+fn multiply_atv(v: &[f64], atv: &mut [f64]) {
+    for (i, out) in atv.iter_mut().enumerate() {
+        let mut sum = 0.0;
+        for (j, x) in v.iter().enumerate() {
+            sum += a(j, i) * x;
+        }
+        *out = sum;
+    }
+}
+
+fn multiply_atav(v: &[f64], out: &mut [f64], tmp: &mut [f64]) {
+    multiply_av(v, tmp);
+    multiply_atv(tmp, out);
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let n: usize = param(1, "BENCH_SIZE", DEFAULT_SIZE);
+
+    let start = Instant::now();
+
+    let mut u = vec![1.0; n];
+    let mut v = vec![0.0; n];
+    let mut tmp = vec![0.0; n];
+    for _ in 0..10 {
+        multiply_atav(&u, &mut v, &mut tmp);
+        multiply_atav(&v, &mut u, &mut tmp);
+    }
+
+    let (mut vbv, mut vv) = (0.0, 0.0);
+    for i in 0..n {
+        vbv += u[i] * v[i];
+        vv += v[i] * v[i];
+    }
+    println!("{:.9}", (vbv / vv).sqrt());
+    println!("METRIC kernel_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Work per workload, fixed across parallelism levels so the levels are
+// comparable. Every implementation uses the same constants.
+const (
+	spawnTasks      = 10000
+	pingPongRounds  = 100000
+	mapReduceItems  = 20000000
+	mutexIncrements = 1000000
+)
+
+// mix is the per-item work of spawn and mapreduce: the upper 16 bits of a
+// 32-bit multiplicative hash
+func mix(i uint64) uint64 {
+	return uint64(uint32(i*2654435761)) >> 16
+}
+
+// share returns how much of total the worker at index gets when it is split
+// across workers
+func share(total, workers, index int) int {
+	n := total / workers
+	if index < total%workers {
+		n++
+	}
+	return n
+}
+
+// spawn starts one goroutine per task and sums their results
+func spawn(workers int) string {
+	results := make(chan uint64, spawnTasks)
+	var wg sync.WaitGroup
+	for i := 0; i < spawnTasks; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results <- mix(uint64(i))
+		}(i)
+	}
+	wg.Wait()
+	close(results)
+
+	var sum uint64
+	for r := range results {
+		sum += r
+	}
+	return fmt.Sprintf("spawn: tasks=%d sum=%d", spawnTasks, sum)
+}
+
+// pingPong bounces a counter between the two goroutines of each pair over
+// unbuffered channels
+func pingPong(workers int) string {
+	var wg sync.WaitGroup
+	for p := 0; p < workers; p++ {
+		rounds := share(pingPongRounds, workers, p)
+		ping, pong := make(chan int), make(chan int)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for n := range ping {
+				pong <- n + 1
+			}
+			close(pong)
+		}()
+		go func() {
+			defer wg.Done()
+			n := 0
+			for r := 0; r < rounds; r++ {
+				ping <- n
+				n = <-pong
+			}
+			close(ping)
+			if n != rounds {
+				panic("lost messages")
+			}
+		}()
+	}
+	wg.Wait()
+	return fmt.Sprintf("pingpong: roundtrips=%d", pingPongRounds)
+}
+
+// mapReduce splits the items into one contiguous chunk per worker and sums
+// the partial sums
+func mapReduce(workers int) string {
+	partial := make([]uint64, workers)
+	var wg sync.WaitGroup
+	start := 0
+	for w := 0; w < workers; w++ {
+		end := start + share(mapReduceItems, workers, w)
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			var sum uint64
+			for i := start; i < end; i++ {
+				sum += mix(uint64(i))
+			}
+			partial[w] = sum
+		}(w, start, end)
+		start = end
+	}
+	wg.Wait()
+
+	var sum uint64
+	for _, s := range partial {
+		sum += s
+	}
+	return fmt.Sprintf("mapreduce: items=%d sum=%d", mapReduceItems, sum)
+}
+
+// mutex has every worker increment one shared counter under a lock
+func mutex(workers int) string {
+	var (
+		mu      sync.Mutex
+		counter int
+		wg      sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		n := share(mutexIncrements, workers, w)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				mu.Lock()
+				counter++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return fmt.Sprintf("mutex: increments=%d counter=%d", mutexIncrements, counter)
+}
+
+// param reads an integer from the positional argument at index, then from
+// the environment variable, then falls back to def
+func param(index int, env string, def int) int {
+	value := os.Getenv(env)
+	if len(os.Args) > index {
+		value = os.Args[index]
+	}
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		fmt.Fprintf(os.Stderr, "invalid %s: %s\n", env, value)
+		os.Exit(1)
+	}
+	return n
+}
+
+func main() {
+	workloads := map[string]func(int) string{
+		"spawn":     spawn,
+		"pingpong":  pingPong,
+		"mapreduce": mapReduce,
+		"mutex":     mutex,
+	}
+	if len(os.Args) < 2 || workloads[os.Args[1]] == nil {
+		fmt.Fprintln(os.Stderr, "usage: main spawn|pingpong|mapreduce|mutex [parallelism]")
+		os.Exit(1)
+	}
+	run := workloads[os.Args[1]]
+	workers := param(2, "BENCH_PARALLELISM", 1)
+
+	// Goroutines are multiplexed over at most workers OS threads
+	runtime.GOMAXPROCS(workers)
+
+	start := time.Now()
+	fmt.Println(run(workers))
+	fmt.Printf("METRIC workload_time=%.3f unit=ms\n", float64(time.Since(start).Nanoseconds())/1e6)
+}
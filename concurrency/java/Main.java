@@ -0,0 +1,173 @@
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Locale;
+import java.util.concurrent.SynchronousQueue;
+import java.util.concurrent.locks.ReentrantLock;
+
+// spawn and pingpong run on virtual threads (Java 21), scheduled over one
+// carrier thread per level of parallelism; mapreduce and mutex run on one
+// platform thread per level.
+public class Main {
+    // Work per workload, fixed across parallelism levels so the levels are
+    // comparable. Every implementation uses the same constants.
+    static final int SPAWN_TASKS = 10000;
+    static final int PING_PONG_ROUNDS = 100000;
+    static final int MAP_REDUCE_ITEMS = 20000000;
+    static final int MUTEX_INCREMENTS = 1000000;
+
+    // The per-item work of spawn and mapreduce: the upper 16 bits of a
+    // 32-bit multiplicative hash
+    static long mix(long i) {
+        return ((i * 2654435761L) & 0xFFFFFFFFL) >>> 16;
+    }
+
+    // How much of total the worker at index gets when it is split across
+    // workers
+    static int share(int total, int workers, int index) {
+        return total / workers + (index < total % workers ? 1 : 0);
+    }
+
+    interface Workload {
+        String run(int workers) throws Exception;
+    }
+
+    // Starts one virtual thread per task and sums their results
+    static String spawn(int workers) throws InterruptedException {
+        long[] results = new long[SPAWN_TASKS];
+        Thread[] threads = new Thread[SPAWN_TASKS];
+        for (int i = 0; i < SPAWN_TASKS; i++) {
+            final int task = i;
+            threads[i] = Thread.ofVirtual().start(() -> results[task] = mix(task));
+        }
+        long sum = 0;
+        for (int i = 0; i < SPAWN_TASKS; i++) {
+            threads[i].join();
+            sum += results[i];
+        }
+        return "spawn: tasks=" + SPAWN_TASKS + " sum=" + sum;
+    }
+
+    // Bounces a counter between the two virtual threads of each pair over
+    // synchronous queues
+    static String pingPong(int workers) throws InterruptedException {
+        List<Thread> threads = new ArrayList<>();
+        for (int p = 0; p < workers; p++) {
+            final int rounds = share(PING_PONG_ROUNDS, workers, p);
+            SynchronousQueue<Integer> ping = new SynchronousQueue<>();
+            SynchronousQueue<Integer> pong = new SynchronousQueue<>();
+            threads.add(Thread.ofVirtual().start(() -> {
+                try {
+                    for (int r = 0; r < rounds; r++) {
+                        pong.put(ping.take() + 1);
+                    }
+                } catch (InterruptedException e) {
+                    throw new RuntimeException(e);
+                }
+            }));
+            threads.add(Thread.ofVirtual().start(() -> {
+                try {
+                    int n = 0;
+                    for (int r = 0; r < rounds; r++) {
+                        ping.put(n);
+                        n = pong.take();
+                    }
+                    if (n != rounds) {
+                        throw new IllegalStateException("lost messages");
+                    }
+                } catch (InterruptedException e) {
+                    throw new RuntimeException(e);
+                }
+            }));
+        }
+        for (Thread t : threads) {
+            t.join();
+        }
+        return "pingpong: roundtrips=" + PING_PONG_ROUNDS;
+    }
+
+    // Splits the items into one contiguous chunk per thread and sums the
+    // partial sums
+    static String mapReduce(int workers) throws InterruptedException {
+        long[] partial = new long[workers];
+        Thread[] threads = new Thread[workers];
+        int start = 0;
+        for (int w = 0; w < workers; w++) {
+            final int index = w, from = start, to = start + share(MAP_REDUCE_ITEMS, workers, w);
+            threads[w] = Thread.ofPlatform().start(() -> {
+                long sum = 0;
+                for (long i = from; i < to; i++) {
+                    sum += mix(i);
+                }
+                partial[index] = sum;
+            });
+            start = to;
+        }
+        long sum = 0;
+        for (int w = 0; w < workers; w++) {
+            threads[w].join();
+            sum += partial[w];
+        }
+        return "mapreduce: items=" + MAP_REDUCE_ITEMS + " sum=" + sum;
+    }
+
+    // Has every thread increment one shared counter under a lock
+    static String mutex(int workers) throws InterruptedException {
+        ReentrantLock lock = new ReentrantLock();
+        int[] counter = new int[1];
+        Thread[] threads = new Thread[workers];
+        for (int w = 0; w < workers; w++) {
+            final int n = share(MUTEX_INCREMENTS, workers, w);
+            threads[w] = Thread.ofPlatform().start(() -> {
+                for (int i = 0; i < n; i++) {
+                    lock.lock();
+                    try {
+                        counter[0]++;
+                    } finally {
+                        lock.unlock();
+                    }
+                }
+            });
+        }
+        for (Thread t : threads) {
+            t.join();
+        }
+        return "mutex: increments=" + MUTEX_INCREMENTS + " counter=" + counter[0];
+    }
+
+    // Positional argument at index, then environment variable, then default
+    static int param(String[] args, int index, String env, int def) {
+        String value = args.length > index ? args[index] : System.getenv(env);
+        if (value == null || value.isEmpty()) {
+            return def;
+        }
+        try {
+            return Math.max(Integer.parseInt(value), 1);
+        } catch (NumberFormatException e) {
+            System.err.println("invalid " + env + ": " + value);
+            System.exit(1);
+            return def;
+        }
+    }
+
+    public static void main(String[] args) throws Exception {
+        Workload run = switch (args.length > 0 ? args[0] : "") {
+            case "spawn" -> Main::spawn;
+            case "pingpong" -> Main::pingPong;
+            case "mapreduce" -> Main::mapReduce;
+            case "mutex" -> Main::mutex;
+            default -> null;
+        };
+        if (run == null) {
+            System.err.println("usage: Main spawn|pingpong|mapreduce|mutex [parallelism]");
+            System.exit(1);
+        }
+        int workers = param(args, 1, "BENCH_PARALLELISM", 1);
+
+        // Read when the first virtual thread is created
+        System.setProperty("jdk.virtualThreadScheduler.parallelism", Integer.toString(workers));
+
+        long start = System.nanoTime();
+        System.out.println(run.run(workers));
+        System.out.printf(Locale.ROOT, "METRIC workload_time=%.3f unit=ms%n", (System.nanoTime() - start) / 1e6);
+    }
+}
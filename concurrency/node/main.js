@@ -0,0 +1,180 @@
+#!/usr/bin/env node
+
+// spawn and pingpong run as promises on the event loop, so they use a single
+// thread at every parallelism level; mapreduce and mutex run on one worker
+// thread per level of parallelism.
+const { Worker, isMainThread, parentPort, workerData } = require("worker_threads");
+
+// Work per workload, fixed across parallelism levels so the levels are
+// comparable. Every implementation uses the same constants.
+const SPAWN_TASKS = 10000;
+const PING_PONG_ROUNDS = 100000;
+const MAP_REDUCE_ITEMS = 20000000;
+const MUTEX_INCREMENTS = 1000000;
+
+// The per-item work of spawn and mapreduce: the upper 16 bits of a 32-bit
+// multiplicative hash
+function mix(i) {
+    return Math.imul(i, 2654435761) >>> 16;
+}
+
+// How much of total the worker at index gets when it is split across workers
+function share(total, workers, index) {
+    return Math.floor(total / workers) + (index < total % workers ? 1 : 0);
+}
+
+// Starts one async task per item and sums their results
+async function spawn(workers) {
+    const tasks = [];
+    for (let i = 0; i < SPAWN_TASKS; i++) {
+        tasks.push((async () => mix(i))());
+    }
+    const results = await Promise.all(tasks);
+    const sum = results.reduce((a, b) => a + b, 0);
+    return `spawn: tasks=${SPAWN_TASKS} sum=${sum}`;
+}
+
+// A channel between async tasks: recv waits until a value has been sent
+class Channel {
+    constructor() {
+        this.values = [];
+        this.waiters = [];
+    }
+
+    send(value) {
+        const waiter = this.waiters.shift();
+        if (waiter) {
+            waiter(value);
+        } else {
+            this.values.push(value);
+        }
+    }
+
+    recv() {
+        if (this.values.length > 0) {
+            return Promise.resolve(this.values.shift());
+        }
+        return new Promise((resolve) => this.waiters.push(resolve));
+    }
+}
+
+// Bounces a counter between the two tasks of each pair over channels
+async function pingPong(workers) {
+    const pairs = [];
+    for (let p = 0; p < workers; p++) {
+        const rounds = share(PING_PONG_ROUNDS, workers, p);
+        const ping = new Channel();
+        const pong = new Channel();
+        const ponger = (async () => {
+            for (let r = 0; r < rounds; r++) {
+                pong.send((await ping.recv()) + 1);
+            }
+        })();
+        const pinger = (async () => {
+            let n = 0;
+            for (let r = 0; r < rounds; r++) {
+                ping.send(n);
+                n = await pong.recv();
+            }
+            if (n !== rounds) {
+                throw new Error("lost messages");
+            }
+        })();
+        pairs.push(ponger, pinger);
+    }
+    await Promise.all(pairs);
+    return `pingpong: roundtrips=${PING_PONG_ROUNDS}`;
+}
+
+// Runs the task on one worker thread per entry of data and returns their
+// results
+function runWorkers(task, data) {
+    return Promise.all(data.map((d) => new Promise((resolve, reject) => {
+        const worker = new Worker(__filename, { workerData: { task, ...d } });
+        worker.once("message", resolve);
+        worker.once("error", reject);
+    })));
+}
+
+// Splits the items into one contiguous chunk per worker thread and sums the
+// partial sums
+async function mapReduce(workers) {
+    const chunks = [];
+    let start = 0;
+    for (let w = 0; w < workers; w++) {
+        const end = start + share(MAP_REDUCE_ITEMS, workers, w);
+        chunks.push({ start, end });
+        start = end;
+    }
+    const partial = await runWorkers("mapreduce", chunks);
+    const sum = partial.reduce((a, b) => a + b, 0);
+    return `mapreduce: items=${MAP_REDUCE_ITEMS} sum=${sum}`;
+}
+
+// Has every worker thread increment one shared counter under a lock built on
+// Atomics: shared[0] is the lock, shared[1] the counter
+async function mutex(workers) {
+    const shared = new Int32Array(new SharedArrayBuffer(8));
+    const data = [];
+    for (let w = 0; w < workers; w++) {
+        data.push({ shared, n: share(MUTEX_INCREMENTS, workers, w) });
+    }
+    await runWorkers("mutex", data);
+    return `mutex: increments=${MUTEX_INCREMENTS} counter=${shared[1]}`;
+}
+
+function workerMain() {
+    const { task } = workerData;
+    if (task === "mapreduce") {
+        let sum = 0;
+        for (let i = workerData.start; i < workerData.end; i++) {
+            sum += mix(i);
+        }
+        parentPort.postMessage(sum);
+    } else {
+        const shared = workerData.shared;
+        for (let i = 0; i < workerData.n; i++) {
+            while (Atomics.compareExchange(shared, 0, 0, 1) !== 0) {
+                Atomics.wait(shared, 0, 1);
+            }
+            shared[1]++;
+            Atomics.store(shared, 0, 0);
+            Atomics.notify(shared, 0, 1);
+        }
+        parentPort.postMessage(null);
+    }
+}
+
+// Positional argument at index, then environment variable, then default
+function param(index, env, def) {
+    const value = process.argv[index + 1] ?? process.env[env] ?? "";
+    if (value === "") {
+        return def;
+    }
+    const n = Number.parseInt(value, 10);
+    if (Number.isNaN(n) || n < 1) {
+        console.error(`invalid ${env}: ${value}`);
+        process.exit(1);
+    }
+    return n;
+}
+
+async function main() {
+    const workloads = { spawn, pingpong: pingPong, mapreduce: mapReduce, mutex };
+    const run = workloads[process.argv[2]];
+    if (!run) {
+        console.error("usage: main.js spawn|pingpong|mapreduce|mutex [parallelism]");
+        process.exit(1);
+    }
+    const workers = param(2, "BENCH_PARALLELISM", 1);
+
+    const start = performance.now();
+    console.log(await run(workers));
+    console.log(`METRIC workload_time=${(performance.now() - start).toFixed(3)} unit=ms`);
+}
+
+if (isMainThread) {
+    main();
+} else {
+    workerMain();
+}
@@ -0,0 +1,134 @@
+#!/usr/bin/env python3
+"""spawn, pingpong and mutex use threads, which the GIL serialises;
+mapreduce uses one process per level of parallelism."""
+import multiprocessing
+import os
+import queue
+import sys
+import threading
+import time
+
+# Work per workload, fixed across parallelism levels so the levels are
+# comparable. Every implementation uses the same constants.
+SPAWN_TASKS = 10000
+PING_PONG_ROUNDS = 100000
+MAP_REDUCE_ITEMS = 20000000
+MUTEX_INCREMENTS = 1000000
+
+
+def mix(i):
+    """The per-item work of spawn and mapreduce: the upper 16 bits of a
+    32-bit multiplicative hash."""
+    return ((i * 2654435761) & 0xFFFFFFFF) >> 16
+
+
+def share(total, workers, index):
+    """How much of total the worker at index gets when it is split across
+    workers."""
+    return total // workers + (1 if index < total % workers else 0)
+
+
+def spawn(workers):
+    """Starts one thread per task, at most workers alive at a time, and sums
+    their results."""
+    results = [0] * SPAWN_TASKS
+
+    def task(i):
+        results[i] = mix(i)
+
+    for first in range(0, SPAWN_TASKS, workers):
+        wave = [threading.Thread(target=task, args=(i,))
+                for i in range(first, min(first + workers, SPAWN_TASKS))]
+        for t in wave:
+            t.start()
+        for t in wave:
+            t.join()
+    return f"spawn: tasks={SPAWN_TASKS} sum={sum(results)}"
+
+
+def ping_pong(workers):
+    """Bounces a counter between the two threads of each pair over queues."""
+    def ponger(ping, pong):
+        while (n := ping.get()) is not None:
+            pong.put(n + 1)
+
+    def pinger(ping, pong, rounds):
+        n = 0
+        for _ in range(rounds):
+            ping.put(n)
+            n = pong.get()
+        ping.put(None)
+        assert n == rounds, "lost messages"
+
+    threads = []
+    for p in range(workers):
+        ping, pong = queue.Queue(maxsize=1), queue.Queue(maxsize=1)
+        rounds = share(PING_PONG_ROUNDS, workers, p)
+        threads.append(threading.Thread(target=ponger, args=(ping, pong)))
+        threads.append(threading.Thread(target=pinger, args=(ping, pong, rounds)))
+    for t in threads:
+        t.start()
+    for t in threads:
+        t.join()
+    return f"pingpong: roundtrips={PING_PONG_ROUNDS}"
+
+
+def map_chunk(bounds):
+    start, end = bounds
+    return sum(mix(i) for i in range(start, end))
+
+
+def map_reduce(workers):
+    """Splits the items into one contiguous chunk per process and sums the
+    partial sums."""
+    chunks = []
+    start = 0
+    for w in range(workers):
+        end = start + share(MAP_REDUCE_ITEMS, workers, w)
+        chunks.append((start, end))
+        start = end
+    with multiprocessing.Pool(workers) as pool:
+        total = sum(pool.map(map_chunk, chunks))
+    return f"mapreduce: items={MAP_REDUCE_ITEMS} sum={total}"
+
+
+def mutex(workers):
+    """Has every thread increment one shared counter under a lock."""
+    lock = threading.Lock()
+    counter = [0]
+
+    def increment(n):
+        for _ in range(n):
+            with lock:
+                counter[0] += 1
+
+    threads = [threading.Thread(target=increment, args=(share(MUTEX_INCREMENTS, workers, w),))
+               for w in range(workers)]
+    for t in threads:
+        t.start()
+    for t in threads:
+        t.join()
+    return f"mutex: increments={MUTEX_INCREMENTS} counter={counter[0]}"
+
+
+def param(index, env, default):
+    """Positional argument at index, then environment variable, then default."""
+    value = sys.argv[index] if len(sys.argv) > index else os.environ.get(env, "")
+    return max(int(value), 1) if value else default
+
+
+def main():
+    workloads = {"spawn": spawn, "pingpong": ping_pong, "mapreduce": map_reduce, "mutex": mutex}
+    run = workloads.get(sys.argv[1] if len(sys.argv) > 1 else "")
+    if run is None:
+        print("usage: main.py spawn|pingpong|mapreduce|mutex [parallelism]", file=sys.stderr)
+        sys.exit(1)
+    workers = param(2, "BENCH_PARALLELISM", 1)
+
+    start = time.perf_counter()
+    print(run(workers))
+    print(f"METRIC workload_time={(time.perf_counter() - start) * 1000:.3f} unit=ms")
+
+
+if __name__ == "__main__":
+    main()
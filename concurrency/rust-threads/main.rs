@@ -0,0 +1,134 @@
+use std::env;
+use std::sync::mpsc;
+use std::sync::{Arc, Mutex};
+use std::thread;
+use std::time::Instant;
+
+// Work per workload, fixed across parallelism levels so the levels are
+// comparable. Every implementation uses the same constants.
+const SPAWN_TASKS: usize = 10000;
+const PING_PONG_ROUNDS: usize = 100000;
+const MAP_REDUCE_ITEMS: usize = 20000000;
+const MUTEX_INCREMENTS: usize = 1000000;
+
+/// The per-item work of spawn and mapreduce: the upper 16 bits of a 32-bit
+/// multiplicative hash.
+fn mix(i: u64) -> u64 {
+    (i.wrapping_mul(2654435761) as u32 >> 16) as u64
+}
+
+/// How much of total the worker at index gets when it is split across workers.
+fn share(total: usize, workers: usize, index: usize) -> usize {
+    total / workers + usize::from(index < total % workers)
+}
+
+/// Spawns one OS thread per task, at most `workers` alive at a time, and sums
+/// their results.
+fn spawn(workers: usize) -> String {
+    let mut sum = 0u64;
+    let mut next = 0;
+    while next < SPAWN_TASKS {
+        let wave: Vec<_> = (next..SPAWN_TASKS.min(next + workers))
+            .map(|i| thread::spawn(move || mix(i as u64)))
+            .collect();
+        next += wave.len();
+        sum += wave.into_iter().map(|h| h.join().unwrap()).sum::<u64>();
+    }
+    format!("spawn: tasks={} sum={}", SPAWN_TASKS, sum)
+}
+
+/// Bounces a counter between the two threads of each pair over channels.
+fn ping_pong(workers: usize) -> String {
+    let mut handles = Vec::new();
+    for p in 0..workers {
+        let rounds = share(PING_PONG_ROUNDS, workers, p);
+        let (ping_tx, ping_rx) = mpsc::sync_channel::<usize>(0);
+        let (pong_tx, pong_rx) = mpsc::sync_channel::<usize>(0);
+        handles.push(thread::spawn(move || {
+            for n in ping_rx {
+                pong_tx.send(n + 1).unwrap();
+            }
+        }));
+        handles.push(thread::spawn(move || {
+            let mut n = 0;
+            for _ in 0..rounds {
+                ping_tx.send(n).unwrap();
+                n = pong_rx.recv().unwrap();
+            }
+            assert_eq!(n, rounds, "lost messages");
+        }));
+    }
+    for h in handles {
+        h.join().unwrap();
+    }
+    format!("pingpong: roundtrips={}", PING_PONG_ROUNDS)
+}
+
+/// Splits the items into one contiguous chunk per thread and sums the
+/// partial sums.
+fn map_reduce(workers: usize) -> String {
+    let mut handles = Vec::new();
+    let mut start = 0;
+    for w in 0..workers {
+        let end = start + share(MAP_REDUCE_ITEMS, workers, w);
+        handles.push(thread::spawn(move || (start..end).map(|i| mix(i as u64)).sum::<u64>()));
+        start = end;
+    }
+    let sum: u64 = handles.into_iter().map(|h| h.join().unwrap()).sum();
+    format!("mapreduce: items={} sum={}", MAP_REDUCE_ITEMS, sum)
+}
+
+/// Has every thread increment one shared counter under a lock.
+fn mutex(workers: usize) -> String {
+    let counter = Arc::new(Mutex::new(0usize));
+    let handles: Vec<_> = (0..workers)
+        .map(|w| {
+            let counter = Arc::clone(&counter);
+            let n = share(MUTEX_INCREMENTS, workers, w);
+            thread::spawn(move || {
+                for _ in 0..n {
+                    *counter.lock().unwrap() += 1;
+                }
+            })
+        })
+        .collect();
+    for h in handles {
+        h.join().unwrap();
+    }
+    let counter = *counter.lock().unwrap();
+    format!("mutex: increments={} counter={}", MUTEX_INCREMENTS, counter)
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let workload = env::args().nth(1).unwrap_or_default();
+    let run: fn(usize) -> String = match workload.as_str() {
+        "spawn" => spawn,
+        "pingpong" => ping_pong,
+        "mapreduce" => map_reduce,
+        "mutex" => mutex,
+        _ => {
+            eprintln!("usage: main spawn|pingpong|mapreduce|mutex [parallelism]");
+            std::process::exit(1);
+        }
+    };
+    let workers: usize = param(2, "BENCH_PARALLELISM", 1).max(1);
+
+    let start = Instant::now();
+    println!("{}", run(workers));
+    println!("METRIC workload_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
@@ -0,0 +1,143 @@
+use std::env;
+use std::sync::Arc;
+use std::time::Instant;
+use tokio::sync::{mpsc, Mutex};
+
+// Work per workload, fixed across parallelism levels so the levels are
+// comparable. Every implementation uses the same constants.
+const SPAWN_TASKS: usize = 10000;
+const PING_PONG_ROUNDS: usize = 100000;
+const MAP_REDUCE_ITEMS: usize = 20000000;
+const MUTEX_INCREMENTS: usize = 1000000;
+
+/// The per-item work of spawn and mapreduce: the upper 16 bits of a 32-bit
+/// multiplicative hash.
+fn mix(i: u64) -> u64 {
+    (i.wrapping_mul(2654435761) as u32 >> 16) as u64
+}
+
+/// How much of total the worker at index gets when it is split across workers.
+fn share(total: usize, workers: usize, index: usize) -> usize {
+    total / workers + usize::from(index < total % workers)
+}
+
+/// Spawns one task per item and sums their results.
+async fn spawn(_workers: usize) -> String {
+    let handles: Vec<_> = (0..SPAWN_TASKS)
+        .map(|i| tokio::spawn(async move { mix(i as u64) }))
+        .collect();
+    let mut sum = 0u64;
+    for h in handles {
+        sum += h.await.unwrap();
+    }
+    format!("spawn: tasks={} sum={}", SPAWN_TASKS, sum)
+}
+
+/// Bounces a counter between the two tasks of each pair over channels.
+async fn ping_pong(workers: usize) -> String {
+    let mut handles = Vec::new();
+    for p in 0..workers {
+        let rounds = share(PING_PONG_ROUNDS, workers, p);
+        let (ping_tx, mut ping_rx) = mpsc::channel::<usize>(1);
+        let (pong_tx, mut pong_rx) = mpsc::channel::<usize>(1);
+        handles.push(tokio::spawn(async move {
+            while let Some(n) = ping_rx.recv().await {
+                pong_tx.send(n + 1).await.unwrap();
+            }
+        }));
+        handles.push(tokio::spawn(async move {
+            let mut n = 0;
+            for _ in 0..rounds {
+                ping_tx.send(n).await.unwrap();
+                n = pong_rx.recv().await.unwrap();
+            }
+            assert_eq!(n, rounds, "lost messages");
+        }));
+    }
+    for h in handles {
+        h.await.unwrap();
+    }
+    format!("pingpong: roundtrips={}", PING_PONG_ROUNDS)
+}
+
+/// Splits the items into one contiguous chunk per task and sums the partial
+/// sums.
+async fn map_reduce(workers: usize) -> String {
+    let mut handles = Vec::new();
+    let mut start = 0;
+    for w in 0..workers {
+        let end = start + share(MAP_REDUCE_ITEMS, workers, w);
+        handles.push(tokio::spawn(async move {
+            (start..end).map(|i| mix(i as u64)).sum::<u64>()
+        }));
+        start = end;
+    }
+    let mut sum = 0u64;
+    for h in handles {
+        sum += h.await.unwrap();
+    }
+    format!("mapreduce: items={} sum={}", MAP_REDUCE_ITEMS, sum)
+}
+
+/// Has every task increment one shared counter under an async lock.
+async fn mutex(workers: usize) -> String {
+    let counter = Arc::new(Mutex::new(0usize));
+    let handles: Vec<_> = (0..workers)
+        .map(|w| {
+            let counter = Arc::clone(&counter);
+            let n = share(MUTEX_INCREMENTS, workers, w);
+            tokio::spawn(async move {
+                for _ in 0..n {
+                    *counter.lock().await += 1;
+                }
+            })
+        })
+        .collect();
+    for h in handles {
+        h.await.unwrap();
+    }
+    let counter = *counter.lock().await;
+    format!("mutex: increments={} counter={}", MUTEX_INCREMENTS, counter)
+}
+
+/// Positional argument at index, then environment variable, then default.
+fn param<T: std::str::FromStr>(index: usize, name: &str, default: T) -> T {
+    let value = env::args()
+        .nth(index)
+        .or_else(|| env::var(name).ok())
+        .filter(|v| !v.is_empty());
+    match value {
+        Some(v) => v.parse().unwrap_or_else(|_| {
+            eprintln!("invalid {}: {}", name, v);
+            std::process::exit(1);
+        }),
+        None => default,
+    }
+}
+
+fn main() {
+    let workload = env::args().nth(1).unwrap_or_default();
+    if !["spawn", "pingpong", "mapreduce", "mutex"].contains(&workload.as_str()) {
+        eprintln!("usage: concurrency spawn|pingpong|mapreduce|mutex [parallelism]");
+        std::process::exit(1);
+    }
+    let workers: usize = param(2, "BENCH_PARALLELISM", 1).max(1);
+
+    // Tasks are multiplexed over `workers` runtime threads
+    let runtime = tokio::runtime::Builder::new_multi_thread()
+        .worker_threads(workers)
+        .build()
+        .unwrap();
+
+    let start = Instant::now();
+    let result = runtime.block_on(async {
+        match workload.as_str() {
+            "spawn" => spawn(workers).await,
+            "pingpong" => ping_pong(workers).await,
+            "mapreduce" => map_reduce(workers).await,
+            _ => mutex(workers).await,
+        }
+    });
+    println!("{}", result);
+    println!("METRIC workload_time={:.3} unit=ms", start.elapsed().as_secs_f64() * 1000.0);
+}
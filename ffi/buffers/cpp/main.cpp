@@ -0,0 +1,34 @@
+#include <iostream>
+#include <chrono>
+#include <iomanip>
+#include <vector>
+#include "../hotpath.h"
+
+using namespace std::chrono;
+
+int main() {
+    const int ITERS = 1000000;
+    const size_t BUF_LEN = 256;
+
+    std::vector<unsigned char> in(BUF_LEN), out(BUF_LEN);
+    for (size_t i = 0; i < BUF_LEN; i++) {
+        in[i] = (unsigned char)i;
+    }
+
+    auto start = high_resolution_clock::now();
+    for (int i = 0; i < ITERS; i++) {
+        buffer_transform(in.data(), out.data(), BUF_LEN);
+        in[0] = out[BUF_LEN - 1];
+    }
+    auto end = high_resolution_clock::now();
+
+    double per_call_ns = duration_cast<nanoseconds>(end - start).count() / (double)ITERS;
+    double total_ms = duration_cast<microseconds>(end - start).count() / 1000.0;
+
+    std::cout << std::fixed << std::setprecision(2);
+    std::cout << "buffer_transform: " << total_ms << " ms total, " << per_call_ns << " ns/call\n";
+    std::cout << "METRIC buffer_transform_total=" << total_ms << " unit=ms\n";
+    std::cout << "METRIC buffer_transform_per_call=" << per_call_ns << " unit=ns\n";
+
+    return 0;
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// bufferTransform is the native Go counterpart of hotpath's buffer_transform
+//
+//go:noinline
+func bufferTransform(in, out []byte) {
+	out = out[:len(in)]
+	for i := range in {
+		out[i] = in[i] ^ 0x5a
+	}
+}
+
+func main() {
+	const ITERS = 1000000
+	const bufLen = 256
+
+	in := make([]byte, bufLen)
+	out := make([]byte, bufLen)
+	for i := range in {
+		in[i] = byte(i)
+	}
+
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		bufferTransform(in, out)
+		in[0] = out[bufLen-1]
+	}
+	elapsed := time.Since(start)
+
+	nativeNs := float64(elapsed.Nanoseconds()) / ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("buffer_transform: %.2f ms total, %.2f ns/call\n", nativeTotalMs, nativeNs)
+	fmt.Printf("METRIC buffer_transform_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC buffer_transform_per_call=%.2f unit=ns\n", nativeNs)
+}
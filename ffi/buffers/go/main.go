@@ -0,0 +1,39 @@
+package main
+
+/*
+#cgo CXXFLAGS: -std=c++11 -O3
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
+#include "../hotpath.h"
+*/
+import "C"
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+func main() {
+	const ITERS = 1000000
+	const bufLen = 256
+
+	in := make([]byte, bufLen)
+	out := make([]byte, bufLen)
+	for i := range in {
+		in[i] = byte(i)
+	}
+
+	// Go memory is passed to C directly; it holds no Go pointers, so cgo
+	// doesn't need to copy it
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		C.buffer_transform((*C.uchar)(unsafe.Pointer(&in[0])), (*C.uchar)(unsafe.Pointer(&out[0])), C.size_t(len(in)))
+		in[0] = out[bufLen-1]
+	}
+	elapsed := time.Since(start)
+
+	ffiNs := float64(elapsed.Nanoseconds()) / ITERS
+	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("buffer_transform: %.2f ms total, %.2f ns/call\n", ffiTotalMs, ffiNs)
+	fmt.Printf("METRIC buffer_transform_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC buffer_transform_per_call=%.2f unit=ns\n", ffiNs)
+}
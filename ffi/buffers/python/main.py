@@ -0,0 +1,30 @@
+import ctypes
+import os
+import time
+
+BUF_LEN = 256
+
+lib = ctypes.CDLL(os.path.join(os.path.dirname(os.path.abspath(__file__)), "libhotpath.so"))
+lib.buffer_transform.argtypes = [ctypes.c_char_p, ctypes.c_char_p, ctypes.c_size_t]
+lib.buffer_transform.restype = None
+
+def main():
+    ITERS = 1000000
+
+    # bytes are passed in without copying; the output is a mutable ctypes buffer
+    data = bytes(range(BUF_LEN))
+    out = ctypes.create_string_buffer(BUF_LEN)
+
+    start = time.perf_counter_ns()
+    for _ in range(ITERS):
+        lib.buffer_transform(data, out, BUF_LEN)
+    end = time.perf_counter_ns()
+
+    total_ms = (end - start) / 1_000_000
+    per_call_ns = (end - start) / ITERS
+    print(f"buffer_transform: {total_ms:.2f} ms total, {per_call_ns:.2f} ns/call")
+    print(f"METRIC buffer_transform_total={total_ms:.2f} unit=ms")
+    print(f"METRIC buffer_transform_per_call={per_call_ns:.2f} unit=ns")
+
+if __name__ == "__main__":
+    main()
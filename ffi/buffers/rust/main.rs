@@ -0,0 +1,27 @@
+use std::hint::black_box;
+use std::time::Instant;
+
+extern "C" {
+    fn buffer_transform(input: *const u8, output: *mut u8, len: usize);
+}
+
+fn main() {
+    const ITERS: i64 = 1_000_000;
+    const BUF_LEN: usize = 256;
+
+    let mut input: Vec<u8> = (0..BUF_LEN).map(|i| i as u8).collect();
+    let mut output = vec![0u8; BUF_LEN];
+
+    let start = Instant::now();
+    for _ in 0..ITERS {
+        unsafe { buffer_transform(input.as_ptr(), output.as_mut_ptr(), input.len()) };
+        input[0] = black_box(output[BUF_LEN - 1]);
+    }
+    let elapsed = start.elapsed();
+
+    let total_ms = elapsed.as_secs_f64() * 1000.0;
+    let per_call_ns = elapsed.as_nanos() as f64 / ITERS as f64;
+    println!("buffer_transform: {:.2} ms total, {:.2} ns/call", total_ms, per_call_ns);
+    println!("METRIC buffer_transform_total={:.2} unit=ms", total_ms);
+    println!("METRIC buffer_transform_per_call={:.2} unit=ns", per_call_ns);
+}
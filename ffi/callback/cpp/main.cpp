@@ -0,0 +1,32 @@
+#include <iostream>
+#include <chrono>
+#include <iomanip>
+#include "../hotpath.h"
+
+using namespace std::chrono;
+
+static long long double_value(long long value, void* ctx) {
+    (void)ctx;
+    return value * 2;
+}
+
+int main() {
+    const int ITERS = 1000000;
+    volatile long long result = 0;
+
+    auto start = high_resolution_clock::now();
+    for (int i = 0; i < ITERS; i++) {
+        result = invoke_callback(double_value, nullptr, i);
+    }
+    auto end = high_resolution_clock::now();
+
+    double per_call_ns = duration_cast<nanoseconds>(end - start).count() / (double)ITERS;
+    double total_ms = duration_cast<microseconds>(end - start).count() / 1000.0;
+
+    std::cout << std::fixed << std::setprecision(2);
+    std::cout << "invoke_callback: " << total_ms << " ms total, " << per_call_ns << " ns/call\n";
+    std::cout << "METRIC invoke_callback_total=" << total_ms << " unit=ms\n";
+    std::cout << "METRIC invoke_callback_per_call=" << per_call_ns << " unit=ns\n";
+
+    return 0;
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func doubleValue(value int64) int64 {
+	return value * 2
+}
+
+// invokeCallback is the native Go counterpart of hotpath's invoke_callback
+//
+//go:noinline
+func invokeCallback(cb func(int64) int64, value int64) int64 {
+	return cb(value) + 1
+}
+
+var sink int64
+
+func main() {
+	const ITERS = 1000000
+
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		sink = invokeCallback(doubleValue, int64(i))
+	}
+	elapsed := time.Since(start)
+
+	nativeNs := float64(elapsed.Nanoseconds()) / ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("invoke_callback: %.2f ms total, %.2f ns/call\n", nativeTotalMs, nativeNs)
+	fmt.Printf("METRIC invoke_callback_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC invoke_callback_per_call=%.2f unit=ns\n", nativeNs)
+}
@@ -0,0 +1,40 @@
+package main
+
+/*
+#cgo CXXFLAGS: -std=c++11 -O3
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
+#include "../hotpath.h"
+
+// Defined in Go below; files using //export may only declare C functions
+long long goCallback(long long value, void* ctx);
+*/
+import "C"
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+//export goCallback
+func goCallback(value C.longlong, ctx unsafe.Pointer) C.longlong {
+	return value * 2
+}
+
+var csink C.longlong
+
+func main() {
+	const ITERS = 1000000
+
+	// Every iteration crosses Go -> C -> Go -> C -> Go
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		csink = C.invoke_callback(C.hp_callback(C.goCallback), nil, C.longlong(i))
+	}
+	elapsed := time.Since(start)
+
+	ffiNs := float64(elapsed.Nanoseconds()) / ITERS
+	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("invoke_callback: %.2f ms total, %.2f ns/call\n", ffiTotalMs, ffiNs)
+	fmt.Printf("METRIC invoke_callback_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC invoke_callback_per_call=%.2f unit=ns\n", ffiNs)
+}
@@ -0,0 +1,50 @@
+#include "hotpath.h"
+
+extern "C" {
+
+// FAST: ~10ns - just sum 8 values, exposes FFI call overhead
+long long fast_sum8(long long a, long long b, long long c, long long d,
+                    long long e, long long f, long long g, long long h) {
+    return a + b + c + d + e + f + g + h;
+}
+
+// SLOW: ~10ms - heavy compute, amortizes FFI overhead
+// Uses a mixing function similar to MurmurHash
+long long slow_compute(long long seed, int iterations) {
+    long long h = seed;
+    for (int i = 0; i < iterations; i++) {
+        h ^= h >> 33;
+        h *= 0xff51afd7ed558ccdULL;
+        h ^= h >> 33;
+        h *= 0xc4ceb9fe1a85ec53ULL;
+        h ^= h >> 33;
+    }
+    return h;
+}
+
+long long string_checksum(const char* s) {
+    long long sum = 0;
+    for (; *s; s++) {
+        sum += (unsigned char)*s;
+    }
+    return sum;
+}
+
+void buffer_transform(const unsigned char* in, unsigned char* out, size_t len) {
+    for (size_t i = 0; i < len; i++) {
+        out[i] = in[i] ^ 0x5a;
+    }
+}
+
+double point_update(hp_point* p, double dt) {
+    p->x += p->vx * dt;
+    p->y += p->vy * dt;
+    p->z += p->vz * dt;
+    return p->x + p->y + p->z;
+}
+
+long long invoke_callback(hp_callback cb, void* ctx, long long value) {
+    return cb(value, ctx) + 1;
+}
+
+}
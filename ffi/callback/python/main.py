@@ -0,0 +1,32 @@
+import ctypes
+import os
+import time
+
+HpCallback = ctypes.CFUNCTYPE(ctypes.c_longlong, ctypes.c_longlong, ctypes.c_void_p)
+
+lib = ctypes.CDLL(os.path.join(os.path.dirname(os.path.abspath(__file__)), "libhotpath.so"))
+lib.invoke_callback.argtypes = [HpCallback, ctypes.c_void_p, ctypes.c_longlong]
+lib.invoke_callback.restype = ctypes.c_longlong
+
+def double_value(value, ctx):
+    return value * 2
+
+def main():
+    ITERS = 1000000
+
+    # Keep a reference to the C thunk so it isn't collected while C holds it
+    callback = HpCallback(double_value)
+
+    start = time.perf_counter_ns()
+    for i in range(ITERS):
+        result = lib.invoke_callback(callback, None, i)
+    end = time.perf_counter_ns()
+
+    total_ms = (end - start) / 1_000_000
+    per_call_ns = (end - start) / ITERS
+    print(f"invoke_callback: {total_ms:.2f} ms total, {per_call_ns:.2f} ns/call")
+    print(f"METRIC invoke_callback_total={total_ms:.2f} unit=ms")
+    print(f"METRIC invoke_callback_per_call={per_call_ns:.2f} unit=ns")
+
+if __name__ == "__main__":
+    main()
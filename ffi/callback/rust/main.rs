@@ -0,0 +1,32 @@
+use std::hint::black_box;
+use std::os::raw::{c_longlong, c_void};
+use std::ptr;
+use std::time::Instant;
+
+type HpCallback = extern "C" fn(c_longlong, *mut c_void) -> c_longlong;
+
+extern "C" {
+    fn invoke_callback(cb: HpCallback, ctx: *mut c_void, value: c_longlong) -> c_longlong;
+}
+
+extern "C" fn double_value(value: c_longlong, _ctx: *mut c_void) -> c_longlong {
+    value * 2
+}
+
+fn main() {
+    const ITERS: i64 = 1_000_000;
+
+    let start = Instant::now();
+    let mut result: i64 = 0;
+    for i in 0..ITERS {
+        result = black_box(unsafe { invoke_callback(double_value, ptr::null_mut(), black_box(i)) });
+    }
+    let _ = black_box(result);
+    let elapsed = start.elapsed();
+
+    let total_ms = elapsed.as_secs_f64() * 1000.0;
+    let per_call_ns = elapsed.as_nanos() as f64 / ITERS as f64;
+    println!("invoke_callback: {:.2} ms total, {:.2} ns/call", total_ms, per_call_ns);
+    println!("METRIC invoke_callback_total={:.2} unit=ms", total_ms);
+    println!("METRIC invoke_callback_per_call={:.2} unit=ns", per_call_ns);
+}
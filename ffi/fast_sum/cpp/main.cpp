@@ -20,6 +20,8 @@ int main() {
     
     std::cout << std::fixed << std::setprecision(2);
     std::cout << "fast_sum8: " << fast_total_ms << " ms total, " << fast_ns << " ns/call\n";
+    std::cout << "METRIC fast_sum8_total=" << fast_total_ms << " unit=ms\n";
+    std::cout << "METRIC fast_sum8_per_call=" << fast_ns << " unit=ns\n";
 
     return 0;
 }
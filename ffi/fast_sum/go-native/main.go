@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// fastSum8 is the native Go counterpart of hotpath's fast_sum8
+//
+//go:noinline
+func fastSum8(a, b, c, d, e, f, g, h int64) int64 {
+	return a + b + c + d + e + f + g + h
+}
+
+var sink int64
+
+func main() {
+	const FAST_ITERS = 1000000
+
+	start := time.Now()
+	for i := 0; i < FAST_ITERS; i++ {
+		sink = fastSum8(1, 2, 3, 4, 5, 6, 7, 8)
+	}
+	elapsed := time.Since(start)
+
+	nativeNs := float64(elapsed.Nanoseconds()) / FAST_ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("fast_sum8: %.2f ms total, %.2f ns/call\n", nativeTotalMs, nativeNs)
+	fmt.Printf("METRIC fast_sum8_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC fast_sum8_per_call=%.2f unit=ns\n", nativeNs)
+}
@@ -2,7 +2,7 @@ package main
 
 /*
 #cgo CXXFLAGS: -std=c++11 -O3
-#cgo LDFLAGS: -L.. -lhotpath
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
 #include "../hotpath.h"
 */
 import "C"
@@ -25,4 +25,6 @@ func main() {
 	ffiNs := float64(elapsed.Nanoseconds()) / FAST_ITERS
 	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
 	fmt.Printf("fast_sum8: %.2f ms total, %.2f ns/call\n", ffiTotalMs, ffiNs)
+	fmt.Printf("METRIC fast_sum8_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC fast_sum8_per_call=%.2f unit=ns\n", ffiNs)
 }
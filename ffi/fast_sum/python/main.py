@@ -14,6 +14,8 @@ def main():
     total_ms = (end - start) / 1_000_000
     per_call_ns = (end - start) / FAST_ITERS
     print(f"fast_sum8: {total_ms:.2f} ms total, {per_call_ns:.2f} ns/call")
+    print(f"METRIC fast_sum8_total={total_ms:.2f} unit=ms")
+    print(f"METRIC fast_sum8_per_call={per_call_ns:.2f} unit=ns")
 
 if __name__ == "__main__":
     main()
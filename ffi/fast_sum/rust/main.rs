@@ -23,4 +23,6 @@ fn main() {
     let total_ms = elapsed.as_secs_f64() * 1000.0;
     let per_call_ns = elapsed.as_nanos() as f64 / FAST_ITERS as f64;
     println!("fast_sum8: {:.2} ms total, {:.2} ns/call", total_ms, per_call_ns);
+    println!("METRIC fast_sum8_total={:.2} unit=ms", total_ms);
+    println!("METRIC fast_sum8_per_call={:.2} unit=ns", per_call_ns);
 }
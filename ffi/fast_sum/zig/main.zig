@@ -33,4 +33,6 @@ pub fn main() !void {
     const total_ms = @as(f64, @floatFromInt(elapsed)) / 1_000_000.0;
     const per_call_ns = @as(f64, @floatFromInt(elapsed)) / @as(f64, @floatFromInt(FAST_ITERS));
     try stdout.print("fast_sum8: {d:.2} ms total, {d:.2} ns/call\n", .{total_ms, per_call_ns});
+    try stdout.print("METRIC fast_sum8_total={d:.2} unit=ms\n", .{total_ms});
+    try stdout.print("METRIC fast_sum8_per_call={d:.2} unit=ns\n", .{per_call_ns});
 }
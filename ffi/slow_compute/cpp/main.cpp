@@ -21,6 +21,8 @@ int main() {
     
     std::cout << std::fixed << std::setprecision(2);
     std::cout << "slow_compute: " << slow_total_ms << " ms total, " << slow_ms << " ms/call\n";
+    std::cout << "METRIC slow_compute_total=" << slow_total_ms << " unit=ms\n";
+    std::cout << "METRIC slow_compute_per_call=" << slow_ms << " unit=ms\n";
 
     return 0;
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// slowCompute is the native Go counterpart of hotpath's slow_compute
+//
+//go:noinline
+func slowCompute(seed int64, iterations int) int64 {
+	h := uint64(seed)
+	for i := 0; i < iterations; i++ {
+		h ^= h >> 33
+		h *= 0xff51afd7ed558ccd
+		h ^= h >> 33
+		h *= 0xc4ceb9fe1a85ec53
+		h ^= h >> 33
+	}
+	return int64(h)
+}
+
+var sink int64
+
+func main() {
+	const SLOW_ITERS = 100
+	const COMPUTE_ITERS = 1000000
+
+	start := time.Now()
+	for i := 0; i < SLOW_ITERS; i++ {
+		sink = slowCompute(int64(i), COMPUTE_ITERS)
+	}
+	elapsed := time.Since(start)
+
+	nativeMs := float64(elapsed.Microseconds()) / 1000.0 / SLOW_ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("slow_compute: %.2f ms total, %.2f ms/call\n", nativeTotalMs, nativeMs)
+	fmt.Printf("METRIC slow_compute_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC slow_compute_per_call=%.2f unit=ms\n", nativeMs)
+}
@@ -2,7 +2,7 @@ package main
 
 /*
 #cgo CXXFLAGS: -std=c++11 -O3
-#cgo LDFLAGS: -L.. -lhotpath
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
 #include "../hotpath.h"
 */
 import "C"
@@ -26,4 +26,6 @@ func main() {
 	ffiMs := float64(elapsed.Microseconds()) / 1000.0 / SLOW_ITERS
 	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
 	fmt.Printf("slow_compute: %.2f ms total, %.2f ms/call\n", ffiTotalMs, ffiMs)
+	fmt.Printf("METRIC slow_compute_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC slow_compute_per_call=%.2f unit=ms\n", ffiMs)
 }
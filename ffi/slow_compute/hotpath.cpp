@@ -22,4 +22,29 @@ long long slow_compute(long long seed, int iterations) {
     return h;
 }
 
+long long string_checksum(const char* s) {
+    long long sum = 0;
+    for (; *s; s++) {
+        sum += (unsigned char)*s;
+    }
+    return sum;
+}
+
+void buffer_transform(const unsigned char* in, unsigned char* out, size_t len) {
+    for (size_t i = 0; i < len; i++) {
+        out[i] = in[i] ^ 0x5a;
+    }
+}
+
+double point_update(hp_point* p, double dt) {
+    p->x += p->vx * dt;
+    p->y += p->vy * dt;
+    p->z += p->vz * dt;
+    return p->x + p->y + p->z;
+}
+
+long long invoke_callback(hp_callback cb, void* ctx, long long value) {
+    return cb(value, ctx) + 1;
+}
+
 }
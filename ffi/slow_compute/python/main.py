@@ -22,6 +22,8 @@ def main():
     total_ms = (end - start) / 1_000_000
     per_call_ms = total_ms / SLOW_ITERS
     print(f"slow_compute: {total_ms:.2f} ms total, {per_call_ms:.2f} ms/call")
+    print(f"METRIC slow_compute_total={total_ms:.2f} unit=ms")
+    print(f"METRIC slow_compute_per_call={per_call_ms:.2f} unit=ms")
 
 if __name__ == "__main__":
     main()
@@ -29,4 +29,6 @@ fn main() {
     let total_ms = elapsed.as_secs_f64() * 1000.0;
     let per_call_ms = total_ms / SLOW_ITERS as f64;
     println!("slow_compute: {:.2} ms total, {:.2} ms/call", total_ms, per_call_ms);
+    println!("METRIC slow_compute_total={:.2} unit=ms", total_ms);
+    println!("METRIC slow_compute_per_call={:.2} unit=ms", per_call_ms);
 }
@@ -33,4 +33,6 @@ pub fn main() !void {
     const total_ms = @as(f64, @floatFromInt(elapsed)) / 1_000_000.0;
     const per_call_ms = total_ms / @as(f64, @floatFromInt(SLOW_ITERS));
     try stdout.print("slow_compute: {d:.2} ms total, {d:.2} ms/call\n", .{total_ms, per_call_ms});
+    try stdout.print("METRIC slow_compute_total={d:.2} unit=ms\n", .{total_ms});
+    try stdout.print("METRIC slow_compute_per_call={d:.2} unit=ms\n", .{per_call_ms});
 }
@@ -0,0 +1,29 @@
+#include <iostream>
+#include <chrono>
+#include <iomanip>
+#include <string>
+#include "../hotpath.h"
+
+using namespace std::chrono;
+
+int main() {
+    const int ITERS = 1000000;
+    const std::string text = "The quick brown fox jumps over the lazy dog, 0123456789 ABCDEFGH";
+    volatile long long result = 0;
+
+    auto start = high_resolution_clock::now();
+    for (int i = 0; i < ITERS; i++) {
+        result = string_checksum(text.c_str());
+    }
+    auto end = high_resolution_clock::now();
+
+    double per_call_ns = duration_cast<nanoseconds>(end - start).count() / (double)ITERS;
+    double total_ms = duration_cast<microseconds>(end - start).count() / 1000.0;
+
+    std::cout << std::fixed << std::setprecision(2);
+    std::cout << "string_checksum: " << total_ms << " ms total, " << per_call_ns << " ns/call\n";
+    std::cout << "METRIC string_checksum_total=" << total_ms << " unit=ms\n";
+    std::cout << "METRIC string_checksum_per_call=" << per_call_ns << " unit=ns\n";
+
+    return 0;
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+const text = "The quick brown fox jumps over the lazy dog, 0123456789 ABCDEFGH"
+
+// stringChecksum is the native Go counterpart of hotpath's string_checksum
+//
+//go:noinline
+func stringChecksum(s string) int64 {
+	var sum int64
+	for i := 0; i < len(s); i++ {
+		sum += int64(s[i])
+	}
+	return sum
+}
+
+var sink int64
+
+func main() {
+	const ITERS = 1000000
+
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		sink = stringChecksum(text)
+	}
+	elapsed := time.Since(start)
+
+	nativeNs := float64(elapsed.Nanoseconds()) / ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("string_checksum: %.2f ms total, %.2f ns/call\n", nativeTotalMs, nativeNs)
+	fmt.Printf("METRIC string_checksum_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC string_checksum_per_call=%.2f unit=ns\n", nativeNs)
+}
@@ -0,0 +1,37 @@
+package main
+
+/*
+#cgo CXXFLAGS: -std=c++11 -O3
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
+#include <stdlib.h>
+#include "../hotpath.h"
+*/
+import "C"
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+const text = "The quick brown fox jumps over the lazy dog, 0123456789 ABCDEFGH"
+
+var csink C.longlong
+
+func main() {
+	const ITERS = 1000000
+
+	// Every call converts the Go string to a C string, as real callers must
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		cs := C.CString(text)
+		csink = C.string_checksum(cs)
+		C.free(unsafe.Pointer(cs))
+	}
+	elapsed := time.Since(start)
+
+	ffiNs := float64(elapsed.Nanoseconds()) / ITERS
+	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("string_checksum: %.2f ms total, %.2f ns/call\n", ffiTotalMs, ffiNs)
+	fmt.Printf("METRIC string_checksum_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC string_checksum_per_call=%.2f unit=ns\n", ffiNs)
+}
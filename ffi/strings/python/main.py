@@ -0,0 +1,27 @@
+import ctypes
+import os
+import time
+
+TEXT = "The quick brown fox jumps over the lazy dog, 0123456789 ABCDEFGH"
+
+lib = ctypes.CDLL(os.path.join(os.path.dirname(os.path.abspath(__file__)), "libhotpath.so"))
+lib.string_checksum.argtypes = [ctypes.c_char_p]
+lib.string_checksum.restype = ctypes.c_longlong
+
+def main():
+    ITERS = 1000000
+
+    # Every call encodes the Python string to bytes, as real callers must
+    start = time.perf_counter_ns()
+    for _ in range(ITERS):
+        result = lib.string_checksum(TEXT.encode())
+    end = time.perf_counter_ns()
+
+    total_ms = (end - start) / 1_000_000
+    per_call_ns = (end - start) / ITERS
+    print(f"string_checksum: {total_ms:.2f} ms total, {per_call_ns:.2f} ns/call")
+    print(f"METRIC string_checksum_total={total_ms:.2f} unit=ms")
+    print(f"METRIC string_checksum_per_call={per_call_ns:.2f} unit=ns")
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,30 @@
+use std::ffi::CString;
+use std::hint::black_box;
+use std::os::raw::{c_char, c_longlong};
+use std::time::Instant;
+
+extern "C" {
+    fn string_checksum(s: *const c_char) -> c_longlong;
+}
+
+const TEXT: &str = "The quick brown fox jumps over the lazy dog, 0123456789 ABCDEFGH";
+
+fn main() {
+    const ITERS: i64 = 1_000_000;
+
+    // Every call converts the Rust string to a C string, as real callers must
+    let start = Instant::now();
+    let mut result: i64 = 0;
+    for _ in 0..ITERS {
+        let cs = CString::new(black_box(TEXT)).unwrap();
+        result = black_box(unsafe { string_checksum(cs.as_ptr()) });
+    }
+    let _ = black_box(result);
+    let elapsed = start.elapsed();
+
+    let total_ms = elapsed.as_secs_f64() * 1000.0;
+    let per_call_ns = elapsed.as_nanos() as f64 / ITERS as f64;
+    println!("string_checksum: {:.2} ms total, {:.2} ns/call", total_ms, per_call_ns);
+    println!("METRIC string_checksum_total={:.2} unit=ms", total_ms);
+    println!("METRIC string_checksum_per_call={:.2} unit=ns", per_call_ns);
+}
@@ -0,0 +1,28 @@
+#include <iostream>
+#include <chrono>
+#include <iomanip>
+#include "../hotpath.h"
+
+using namespace std::chrono;
+
+int main() {
+    const int ITERS = 1000000;
+    hp_point p = {0.0, 0.0, 0.0, 1.0, 2.0, 3.0};
+    volatile double result = 0;
+
+    auto start = high_resolution_clock::now();
+    for (int i = 0; i < ITERS; i++) {
+        result = point_update(&p, 0.001);
+    }
+    auto end = high_resolution_clock::now();
+
+    double per_call_ns = duration_cast<nanoseconds>(end - start).count() / (double)ITERS;
+    double total_ms = duration_cast<microseconds>(end - start).count() / 1000.0;
+
+    std::cout << std::fixed << std::setprecision(2);
+    std::cout << "point_update: " << total_ms << " ms total, " << per_call_ns << " ns/call\n";
+    std::cout << "METRIC point_update_total=" << total_ms << " unit=ms\n";
+    std::cout << "METRIC point_update_per_call=" << per_call_ns << " unit=ns\n";
+
+    return 0;
+}
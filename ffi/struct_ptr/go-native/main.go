@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+type point struct {
+	x, y, z    float64
+	vx, vy, vz float64
+}
+
+// pointUpdate is the native Go counterpart of hotpath's point_update
+//
+//go:noinline
+func pointUpdate(p *point, dt float64) float64 {
+	p.x += p.vx * dt
+	p.y += p.vy * dt
+	p.z += p.vz * dt
+	return p.x + p.y + p.z
+}
+
+var sink float64
+
+func main() {
+	const ITERS = 1000000
+
+	p := &point{vx: 1, vy: 2, vz: 3}
+
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		sink = pointUpdate(p, 0.001)
+	}
+	elapsed := time.Since(start)
+
+	nativeNs := float64(elapsed.Nanoseconds()) / ITERS
+	nativeTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("point_update: %.2f ms total, %.2f ns/call\n", nativeTotalMs, nativeNs)
+	fmt.Printf("METRIC point_update_total=%.2f unit=ms\n", nativeTotalMs)
+	fmt.Printf("METRIC point_update_per_call=%.2f unit=ns\n", nativeNs)
+}
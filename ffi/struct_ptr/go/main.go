@@ -0,0 +1,33 @@
+package main
+
+/*
+#cgo CXXFLAGS: -std=c++11 -O3
+#cgo LDFLAGS: -L${SRCDIR}/.. -lhotpath -lstdc++
+#include "../hotpath.h"
+*/
+import "C"
+import (
+	"fmt"
+	"time"
+)
+
+var csink C.double
+
+func main() {
+	const ITERS = 1000000
+
+	// The struct is Go memory with C layout, passed by pointer
+	p := C.hp_point{vx: 1, vy: 2, vz: 3}
+
+	start := time.Now()
+	for i := 0; i < ITERS; i++ {
+		csink = C.point_update(&p, 0.001)
+	}
+	elapsed := time.Since(start)
+
+	ffiNs := float64(elapsed.Nanoseconds()) / ITERS
+	ffiTotalMs := float64(elapsed.Microseconds()) / 1000.0
+	fmt.Printf("point_update: %.2f ms total, %.2f ns/call\n", ffiTotalMs, ffiNs)
+	fmt.Printf("METRIC point_update_total=%.2f unit=ms\n", ffiTotalMs)
+	fmt.Printf("METRIC point_update_per_call=%.2f unit=ns\n", ffiNs)
+}
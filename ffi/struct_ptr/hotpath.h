@@ -0,0 +1,46 @@
+#ifndef HOTPATH_H
+#define HOTPATH_H
+
+#include <stddef.h>
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// FAST: ~10ns work per call - exposes FFI latency cost
+// Sum 8 integers (fits in registers, minimal work)
+long long fast_sum8(long long a, long long b, long long c, long long d,
+                    long long e, long long f, long long g, long long h);
+
+// SLOW: ~10ms work per call - amortizes FFI overhead
+// Compute N iterations of a hash-like mixing function
+long long slow_compute(long long seed, int iterations);
+
+// STRING: sum the bytes of a NUL-terminated string
+// Exposes string marshalling (copying, encoding, NUL termination)
+long long string_checksum(const char* s);
+
+// BUFFER: write in[i] ^ 0x5a to out[i] for len bytes
+// Exposes passing byte buffers in and out
+void buffer_transform(const unsigned char* in, unsigned char* out, size_t len);
+
+// STRUCT: advance a point by its velocity and return its new x + y + z
+// Exposes passing a struct by pointer and reading it back
+typedef struct {
+    double x, y, z;
+    double vx, vy, vz;
+} hp_point;
+
+double point_update(hp_point* p, double dt);
+
+// CALLBACK: call back into the host language once and add 1 to its result
+// Exposes the cost of calling from C into the host language
+typedef long long (*hp_callback)(long long value, void* ctx);
+
+long long invoke_callback(hp_callback cb, void* ctx, long long value);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif // HOTPATH_H
@@ -0,0 +1,30 @@
+import ctypes
+import os
+import time
+
+class HpPoint(ctypes.Structure):
+    _fields_ = [(name, ctypes.c_double) for name in ("x", "y", "z", "vx", "vy", "vz")]
+
+lib = ctypes.CDLL(os.path.join(os.path.dirname(os.path.abspath(__file__)), "libhotpath.so"))
+lib.point_update.argtypes = [ctypes.POINTER(HpPoint), ctypes.c_double]
+lib.point_update.restype = ctypes.c_double
+
+def main():
+    ITERS = 1000000
+
+    p = HpPoint(0.0, 0.0, 0.0, 1.0, 2.0, 3.0)
+    p_ref = ctypes.byref(p)
+
+    start = time.perf_counter_ns()
+    for _ in range(ITERS):
+        result = lib.point_update(p_ref, 0.001)
+    end = time.perf_counter_ns()
+
+    total_ms = (end - start) / 1_000_000
+    per_call_ns = (end - start) / ITERS
+    print(f"point_update: {total_ms:.2f} ms total, {per_call_ns:.2f} ns/call")
+    print(f"METRIC point_update_total={total_ms:.2f} unit=ms")
+    print(f"METRIC point_update_per_call={per_call_ns:.2f} unit=ns")
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,36 @@
+use std::hint::black_box;
+use std::time::Instant;
+
+#[repr(C)]
+struct HpPoint {
+    x: f64,
+    y: f64,
+    z: f64,
+    vx: f64,
+    vy: f64,
+    vz: f64,
+}
+
+extern "C" {
+    fn point_update(p: *mut HpPoint, dt: f64) -> f64;
+}
+
+fn main() {
+    const ITERS: i64 = 1_000_000;
+
+    let mut p = HpPoint { x: 0.0, y: 0.0, z: 0.0, vx: 1.0, vy: 2.0, vz: 3.0 };
+
+    let start = Instant::now();
+    let mut result: f64 = 0.0;
+    for _ in 0..ITERS {
+        result = black_box(unsafe { point_update(&mut p, black_box(0.001)) });
+    }
+    let _ = black_box(result);
+    let elapsed = start.elapsed();
+
+    let total_ms = elapsed.as_secs_f64() * 1000.0;
+    let per_call_ns = elapsed.as_nanos() as f64 / ITERS as f64;
+    println!("point_update: {:.2} ms total, {:.2} ns/call", total_ms, per_call_ns);
+    println!("METRIC point_update_total={:.2} unit=ms", total_ms);
+    println!("METRIC point_update_per_call={:.2} unit=ns", per_call_ns);
+}
@@ -3,9 +3,9 @@ package benchmark
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -16,6 +16,7 @@ import (
 type Result struct {
 	ServerName   string    `json:"server_name"`
 	ReqPerSec    float64   `json:"req_per_sec"`
+	LatencyMs    float64   `json:"latency_ms"` // mean, estimated from the request rate
 	Connections  int       `json:"connections"`
 	Pipeline     int       `json:"pipeline"`
 	Duration     int       `json:"duration_seconds"`
@@ -29,20 +30,46 @@ type Runner struct {
 	connections int
 	pipeline    int
 	duration    int
+	log         io.Writer // progress and the output of the load test
 }
 
-func NewRunner(binaryPath string, connections, pipeline, duration int) *Runner {
+// NewRunner returns a runner of the load test at binaryPath that writes
+// its progress and the load test's output to log.
+func NewRunner(binaryPath string, connections, pipeline, duration int, log io.Writer) *Runner {
 	return &Runner{
 		binaryPath:  binaryPath,
 		connections: connections,
 		pipeline:    pipeline,
 		duration:    duration,
+		log:         log,
 	}
 }
 
-func (r *Runner) Run(serverName string, port int, serverPID int) (*Result, error) {
-	fmt.Printf("\nRunning benchmark for %s...\n", serverName)
-	fmt.Printf("  Connections: %d, Pipeline: %d, Duration: %ds\n", r.connections, r.pipeline, r.duration)
+// Command returns the load test invocation for a server on port:
+// http_load_test <connections> <host> <port> [pipeline], through stdbuf to
+// unbuffer its output.
+func (r *Runner) Command(port int) []string {
+	return []string{
+		"stdbuf", "-oL", "-eL",
+		r.binaryPath,
+		strconv.Itoa(r.connections),
+		"localhost",
+		strconv.Itoa(port),
+		strconv.Itoa(r.pipeline),
+	}
+}
+
+// Wait is how long Run lets the load test run before stopping it: the
+// duration plus a few seconds for warmup.
+func (r *Runner) Wait() time.Duration {
+	return time.Duration(r.duration+5) * time.Second
+}
+
+// Run puts the server on port under load for the configured duration. If
+// ctx is done first, the load test is stopped and ctx's error is returned.
+func (r *Runner) Run(ctx context.Context, serverName string, port int, serverPID int) (*Result, error) {
+	fmt.Fprintf(r.log, "\nRunning benchmark for %s...\n", serverName)
+	fmt.Fprintf(r.log, "  Connections: %d, Pipeline: %d, Duration: %ds\n", r.connections, r.pipeline, r.duration)
 
 	result := &Result{
 		ServerName:  serverName,
@@ -52,23 +79,15 @@ func (r *Runner) Run(serverName string, port int, serverPID int) (*Result, error
 		Timestamp:   time.Now(),
 	}
 
-	// Run: http_load_test <connections> <host> <port> [pipeline]
-	// Use stdbuf to unbuffer output
-	cmd := exec.Command(
-		"stdbuf", "-oL", "-eL",
-		r.binaryPath,
-		strconv.Itoa(r.connections),
-		"localhost",
-		strconv.Itoa(port),
-		strconv.Itoa(r.pipeline),
-	)
+	args := r.Command(port)
+	cmd := exec.Command(args[0], args[1:]...)
 	
 	var stdBuffer bytes.Buffer
-	mw := io.MultiWriter(os.Stdout, &stdBuffer)
+	mw := io.MultiWriter(r.log, &stdBuffer)
 	cmd.Stdout = mw
 	cmd.Stderr = mw
 	
-	fmt.Printf("  Running benchmark for %d seconds...\n", r.duration)
+	fmt.Fprintf(r.log, "  Running benchmark for %d seconds...\n", r.duration)
 	
 	// Set process group so we can kill all children
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
@@ -79,10 +98,20 @@ func (r *Runner) Run(serverName string, port int, serverPID int) (*Result, error
 		return result, err
 	}
 	
-	fmt.Printf("  Benchmark process started (PID %d), waiting %d seconds...\n", cmd.Process.Pid, r.duration+5)
+	fmt.Fprintf(r.log, "  Benchmark process started (PID %d), waiting %d seconds...\n", cmd.Process.Pid, r.duration+5)
 	
 	// Wait for duration + extra seconds for warmup
-	time.Sleep(time.Duration(r.duration+5) * time.Second)
+	timer := time.NewTimer(r.Wait())
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		fmt.Fprintf(r.log, "  Interrupted, killing benchmark process group (PID %d)...\n", cmd.Process.Pid)
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		cmd.Wait()
+		result.Error = "interrupted"
+		return result, ctx.Err()
+	}
 	
 	// Get memory usage before killing
 	if serverPID > 0 {
@@ -91,15 +120,15 @@ func (r *Runner) Run(serverName string, port int, serverPID int) (*Result, error
 	
 	// Kill the entire process group
 	if cmd.Process != nil {
-		fmt.Printf("  Killing benchmark process group (PID %d)...\n", cmd.Process.Pid)
+		fmt.Fprintf(r.log, "  Killing benchmark process group (PID %d)...\n", cmd.Process.Pid)
 		// Kill the process group (negative PID)
 		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
 	}
 	cmd.Wait()
-	fmt.Printf("  Process terminated.\n")
+	fmt.Fprintf(r.log, "  Process terminated.\n")
 	
 	content := stdBuffer.Bytes()
-	fmt.Printf("  Captured %d bytes\n", len(content))
+	fmt.Fprintf(r.log, "  Captured %d bytes\n", len(content))
 	
 	var reqPerSecValues []float64
 	scanner := bufio.NewScanner(bytes.NewReader(content))
@@ -129,8 +158,15 @@ func (r *Runner) Run(serverName string, port int, serverPID int) (*Result, error
 		return result, fmt.Errorf("no results")
 	}
 
-	fmt.Printf("  Average Req/sec: %.2f\n", result.ReqPerSec)
-	fmt.Printf("  Memory usage: %.2f MB\n", result.MemoryMB)
+	// Every connection keeps pipeline requests in flight, so by Little's
+	// law each one takes connections*pipeline/rate on average
+	if result.ReqPerSec > 0 {
+		result.LatencyMs = float64(r.connections*r.pipeline) / result.ReqPerSec * 1000
+	}
+
+	fmt.Fprintf(r.log, "  Average Req/sec: %.2f\n", result.ReqPerSec)
+	fmt.Fprintf(r.log, "  Estimated latency: %.2f ms\n", result.LatencyMs)
+	fmt.Fprintf(r.log, "  Memory usage: %.2f MB\n", result.MemoryMB)
 	return result, nil
 }
 
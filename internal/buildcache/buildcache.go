@@ -0,0 +1,202 @@
+package buildcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/benchmarks/internal/workspace"
+)
+
+// versionCmds lists the commands used to identify a toolchain. Tools not
+// listed here are identified with "<tool> --version".
+var versionCmds = map[string]string{
+	"go":    "go version",
+	"cargo": "cargo --version && rustc --version",
+	"cmake": "cmake --version && cc --version && c++ --version",
+	"zig":   "zig version",
+	"javac": "javac -version",
+	"npx":   "node --version && npx --version",
+}
+
+// Cache is a content-addressed store of build artifacts. Entries are keyed
+// by a hash of the source files, the compile command and the versions of
+// the tools it invokes, so an entry is only reused when none of them changed.
+type Cache struct {
+	dir string
+
+	mu       sync.Mutex
+	versions map[string]string
+}
+
+// New returns a cache storing its entries under dir.
+func New(dir string) *Cache {
+	return &Cache{dir: dir, versions: make(map[string]string)}
+}
+
+// DefaultDir returns the per-user cache directory for build artifacts.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "benchrunner", "builds"), nil
+}
+
+// Dir returns the directory holding the cache entries.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// Key hashes every file under srcRoot together with the compile command,
+// the artifacts it produces and the toolchain versions.
+func (c *Cache) Key(srcRoot, compileCmd string, artifacts []string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "cmd %s\n", compileCmd)
+	for _, artifact := range artifacts {
+		fmt.Fprintf(h, "artifact %s\n", artifact)
+	}
+	for _, tool := range workspace.Tools(compileCmd) {
+		fmt.Fprintf(h, "tool %s %s\n", tool, c.toolVersion(tool))
+	}
+
+	var files []string
+	err := filepath.WalkDir(srcRoot, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	for _, path := range files {
+		rel, err := filepath.Rel(srcRoot, path)
+		if err != nil {
+			return "", err
+		}
+		info, err := os.Lstat(path)
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(h, "link %s %s\n", filepath.ToSlash(rel), link)
+			continue
+		}
+		fmt.Fprintf(h, "file %s %d\n", filepath.ToSlash(rel), info.Size())
+		if err := hashFile(h, path); err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Restore copies the artifacts stored under key into workDir. It reports
+// false if there is no entry for key.
+func (c *Cache) Restore(key, workDir string) (bool, error) {
+	entry := filepath.Join(c.dir, key)
+	files, err := os.ReadDir(entry)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, f := range files {
+		if err := workspace.Copy(filepath.Join(entry, f.Name()), filepath.Join(workDir, f.Name())); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Store saves the artifacts of workDir under key. Artifacts are glob
+// patterns relative to workDir, each of which must match at least one
+// path. The entry is written to a temporary directory first and renamed
+// into place, so concurrent runs never observe a partial entry.
+func (c *Cache) Store(key, workDir string, artifacts []string) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(c.dir, key+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, artifact := range artifacts {
+		paths, err := filepath.Glob(filepath.Join(workDir, artifact))
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("artifact %s: no such file", artifact)
+		}
+		for _, path := range paths {
+			rel, err := filepath.Rel(workDir, path)
+			if err != nil {
+				return err
+			}
+			if err := workspace.Copy(path, filepath.Join(tmp, rel)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Rename(tmp, filepath.Join(c.dir, key)); err != nil {
+		// Another run may have stored the same key in the meantime
+		if _, statErr := os.Stat(filepath.Join(c.dir, key)); statErr == nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// toolVersion returns the version output of tool, running it at most once
+// per cache.
+func (c *Cache) toolVersion(tool string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := c.versions[tool]; ok {
+		return v
+	}
+	cmdLine, ok := versionCmds[tool]
+	if !ok {
+		cmdLine = tool + " --version"
+	}
+	out, err := exec.Command("sh", "-c", cmdLine).CombinedOutput()
+	v := strings.TrimSpace(string(out))
+	if err != nil {
+		v = "unknown"
+	}
+	c.versions[tool] = v
+	return v
+}
+
+func hashFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
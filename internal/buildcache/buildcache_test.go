@@ -0,0 +1,112 @@
+package buildcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestCache returns a cache under a temporary directory that reports
+// the given tool versions instead of running the tools
+func newTestCache(t *testing.T, versions map[string]string) *Cache {
+	t.Helper()
+	c := New(t.TempDir())
+	for tool, v := range versions {
+		c.versions[tool] = v
+	}
+	return c
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	src := t.TempDir()
+	writeFiles(t, src, map[string]string{"main.go": "package main", "go.mod": "module m"})
+	otherSrc := t.TempDir()
+	writeFiles(t, otherSrc, map[string]string{"main.go": "package main // changed", "go.mod": "module m"})
+
+	const cmd = "go build -o main"
+	artifacts := []string{"main"}
+	versions := map[string]string{"go": "go version go1.21.0"}
+	baseKey, err := newTestCache(t, versions).Key(src, cmd, artifacts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		src, cmd  string
+		artifacts []string
+		versions  map[string]string
+		wantSame  bool
+	}{
+		{name: "unchanged", src: src, cmd: cmd, artifacts: artifacts, versions: versions, wantSame: true},
+		{name: "compile command", src: src, cmd: "go build -ldflags=-s -o main", artifacts: artifacts, versions: versions},
+		{name: "tool version", src: src, cmd: cmd, artifacts: artifacts, versions: map[string]string{"go": "go version go1.22.0"}},
+		{name: "unknown tool version", src: src, cmd: cmd, artifacts: artifacts, versions: map[string]string{"go": "unknown"}},
+		{name: "source file", src: otherSrc, cmd: cmd, artifacts: artifacts, versions: versions},
+		{name: "artifacts", src: src, cmd: cmd, artifacts: []string{"main", "*.so"}, versions: versions},
+		// only the tools the command invokes are part of the key
+		{name: "other tool version", src: src, cmd: cmd, artifacts: artifacts, versions: map[string]string{"go": "go version go1.21.0", "cargo": "cargo 1.80.0"}, wantSame: true},
+	}
+	for _, tt := range tests {
+		key, err := newTestCache(t, tt.versions).Key(tt.src, tt.cmd, tt.artifacts)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if same := key == baseKey; same != tt.wantSame {
+			t.Errorf("%s: key unchanged = %v, want %v", tt.name, same, tt.wantSame)
+		}
+	}
+}
+
+func TestStoreRestore(t *testing.T) {
+	c := newTestCache(t, nil)
+	work := t.TempDir()
+	writeFiles(t, work, map[string]string{
+		"Main.java":           "class Main {}",
+		"Main.class":          "main",
+		"Main$Workload.class": "workload",
+		"bin/app":             "app",
+	})
+
+	if err := c.Store("missing", work, []string{"Main.class", "*.jar"}); err == nil {
+		t.Error("Store with an artifact matching nothing succeeded")
+	}
+	if err := c.Store("k", work, []string{"Main.class", "*.class", "bin"}); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := t.TempDir()
+	if hit, err := c.Restore("other", dst); err != nil || hit {
+		t.Errorf("Restore of an unknown key = %v, %v; want false, nil", hit, err)
+	}
+	hit, err := c.Restore("k", dst)
+	if err != nil || !hit {
+		t.Fatalf("Restore = %v, %v; want true, nil", hit, err)
+	}
+	for name, want := range map[string]string{"Main.class": "main", "Main$Workload.class": "workload", "bin/app": "app"} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Errorf("%s not restored: %v", name, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dst, "Main.java")); err == nil {
+		t.Error("Main.java restored though it is no artifact")
+	}
+}
@@ -2,6 +2,7 @@ package builder
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,9 +13,12 @@ type Builder struct {
 	uSocketsPath    string
 	outputBinary    string
 	uWebSocketsBin  string
+	log             io.Writer // progress and the output of make
 }
 
-func New(baseDir string) *Builder {
+// New returns the builder of the load test and uWebSockets server of the
+// repository at baseDir, which writes its progress to log.
+func New(baseDir string, log io.Writer) *Builder {
 	uWebSocketsPath := filepath.Join(baseDir, "api", "uWebSockets")
 	uSocketsPath := filepath.Join(uWebSocketsPath, "uSockets")
 	outputBinary := filepath.Join(baseDir, "bin", "http_load_test")
@@ -25,6 +29,7 @@ func New(baseDir string) *Builder {
 		uSocketsPath:    uSocketsPath,
 		outputBinary:    outputBinary,
 		uWebSocketsBin:  uWebSocketsBin,
+		log:             log,
 	}
 }
 
@@ -54,43 +59,43 @@ func (b *Builder) Build() error {
 }
 
 func (b *Builder) buildUSockets() error {
-	fmt.Println("Building uSockets library...")
+	fmt.Fprintln(b.log, "Building uSockets library...")
 
 	// Check if already built
 	uSocketsLib := filepath.Join(b.uSocketsPath, "uSockets.a")
 	if _, err := os.Stat(uSocketsLib); err == nil {
-		fmt.Println("uSockets.a already exists, skipping build")
+		fmt.Fprintln(b.log, "uSockets.a already exists, skipping build")
 		return nil
 	}
 
 	makeCmd := exec.Command("make", "default")
 	makeCmd.Dir = b.uSocketsPath
 	makeCmd.Env = append(os.Environ(), "WITH_OPENSSL=0")
-	makeCmd.Stdout = os.Stdout
+	makeCmd.Stdout = b.log
 	makeCmd.Stderr = os.Stderr
 	
 	if err := makeCmd.Run(); err != nil {
 		return fmt.Errorf("failed to build uSockets: %w", err)
 	}
 
-	fmt.Println("uSockets library built successfully")
+	fmt.Fprintln(b.log, "uSockets library built successfully")
 	return nil
 }
 
 func (b *Builder) buildLoadTest() error {
 	// Check if already built
 	if _, err := os.Stat(b.outputBinary); err == nil {
-		fmt.Println("http_load_test already exists, skipping build")
+		fmt.Fprintln(b.log, "http_load_test already exists, skipping build")
 		return nil
 	}
 
-	fmt.Println("Building http_load_test...")
+	fmt.Fprintln(b.log, "Building http_load_test...")
 	
 	// Use uSockets Makefile to build examples (includes http_load_test)
 	makeCmd := exec.Command("make", "examples")
 	makeCmd.Dir = b.uSocketsPath
 	makeCmd.Env = append(os.Environ(), "WITH_OPENSSL=0")
-	makeCmd.Stdout = os.Stdout
+	makeCmd.Stdout = b.log
 	makeCmd.Stderr = os.Stderr
 	
 	if err := makeCmd.Run(); err != nil {
@@ -103,18 +108,18 @@ func (b *Builder) buildLoadTest() error {
 		return fmt.Errorf("failed to copy http_load_test: %w", err)
 	}
 
-	fmt.Printf("Successfully built: %s\n", b.outputBinary)
+	fmt.Fprintf(b.log, "Successfully built: %s\n", b.outputBinary)
 	return nil
 }
 
 func (b *Builder) buildUWebSocketsServer() error {
 	// Check if already built
 	if _, err := os.Stat(b.uWebSocketsBin); err == nil {
-		fmt.Println("HelloWorldBenchmark already exists, skipping build")
+		fmt.Fprintln(b.log, "HelloWorldBenchmark already exists, skipping build")
 		return nil
 	}
 
-	fmt.Println("Building uWebSockets HelloWorldBenchmark server...")
+	fmt.Fprintln(b.log, "Building uWebSockets HelloWorldBenchmark server...")
 
 	// Build using GNU Make (Linux)
 	makeCmd := exec.Command("make", "examples")
@@ -124,7 +129,7 @@ func (b *Builder) buildUWebSocketsServer() error {
 		"WITH_ZLIB=0",
 		"WITH_LTO=0",
 	)
-	makeCmd.Stdout = os.Stdout
+	makeCmd.Stdout = b.log
 	makeCmd.Stderr = os.Stderr
 	
 	if err := makeCmd.Run(); err != nil {
@@ -138,10 +143,26 @@ func (b *Builder) buildUWebSocketsServer() error {
 		return fmt.Errorf("failed to copy HelloWorld: %w", err)
 	}
 
-	fmt.Printf("Successfully built: %s\n", b.uWebSocketsBin)
+	fmt.Fprintf(b.log, "Successfully built: %s\n", b.uWebSocketsBin)
 	return nil
 }
 
+// Command is a build command and the file whose existence skips it
+type Command struct {
+	Dir     string
+	Command string
+	Unless  string
+}
+
+// Commands lists what Build runs, without running anything.
+func (b *Builder) Commands() []Command {
+	return []Command{
+		{Dir: b.uSocketsPath, Command: "WITH_OPENSSL=0 make default", Unless: filepath.Join(b.uSocketsPath, "uSockets.a")},
+		{Dir: b.uSocketsPath, Command: "WITH_OPENSSL=0 make examples && cp http_load_test " + b.outputBinary, Unless: b.outputBinary},
+		{Dir: b.uWebSocketsPath, Command: "WITH_OPENSSL=0 WITH_ZLIB=0 WITH_LTO=0 make examples && cp HelloWorld " + b.uWebSocketsBin, Unless: b.uWebSocketsBin},
+	}
+}
+
 func (b *Builder) GetBinaryPath() string {
 	return b.outputBinary
 }
@@ -7,6 +7,7 @@ type ServerConfig struct {
 	Dir      string
 	StartCmd []string
 	Port     int
+	Tags     map[string]string // lang, framework, kind and runtime, for --select
 }
 
 func GetServers(baseDir string) []ServerConfig {
@@ -19,36 +20,42 @@ func GetServers(baseDir string) []ServerConfig {
 			Dir:      filepath.Join(baseDir, "api", "go-http"),
 			StartCmd: []string{"go", "run", "main.go"},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "go", "framework": "stdlib", "kind": "compiled", "runtime": "native"},
 		},
 		{
 			Name:     "go-fasthttp",
 			Dir:      filepath.Join(baseDir, "api", "go-fasthttp"),
 			StartCmd: []string{"go", "run", "main.go"},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "go", "framework": "fasthttp", "kind": "compiled", "runtime": "native"},
 		},
 		{
 			Name:     "python-fastapi",
 			Dir:      filepath.Join(baseDir, "api", "python-fastapi"),
 			StartCmd: []string{"python3", "main.py"},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "python", "framework": "fastapi", "kind": "interpreted", "runtime": "python"},
 		},
 		{
 			Name:     "node-http",
 			Dir:      filepath.Join(baseDir, "api", "node-http"),
 			StartCmd: []string{"node", "index.js"},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "javascript", "framework": "stdlib", "kind": "interpreted", "runtime": "node"},
 		},
 		{
 			Name:     "nginx-static",
 			Dir:      filepath.Join(baseDir, "api", "nginx-static"),
 			StartCmd: []string{"nginx", "-p", ".", "-c", "nginx.conf"},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "c", "framework": "nginx", "kind": "compiled", "runtime": "native"},
 		},
 		{
 			Name:     "cpp-uwebsockets",
 			Dir:      binDir,
 			StartCmd: []string{uWebSocketsBin},
 			Port:     8080,
+			Tags:     map[string]string{"lang": "cpp", "framework": "uwebsockets", "kind": "compiled", "runtime": "native"},
 		},
 	}
 }
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ProjectFile is the name of the configuration file benchrunner reads from
+// the repository root.
+const ProjectFile = "benchrunner.yaml"
+
+// Project is the configuration file: flag values every invocation starts
+// from, and named profiles that override them.
+type Project struct {
+	Path     string
+	Defaults Profile
+	Profiles map[string]Profile
+}
+
+// Profile holds flag values by flag name: shared flags such as runs, mode
+// or output, and the flags of individual suites by suite name. Values are
+// in their command-line form; lists are comma-separated.
+type Profile struct {
+	Flags  map[string]string
+	Suites map[string]map[string]string
+}
+
+// LoadProject reads the configuration file at path. A missing file is not
+// an error: it returns nil.
+func LoadProject(path string) (*Project, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Defaults map[string]any            `yaml:"defaults"`
+		Profiles map[string]map[string]any `yaml:"profiles"`
+	}
+	if err := yaml.UnmarshalWithOptions(data, &file, yaml.Strict()); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	p := &Project{Path: path, Profiles: make(map[string]Profile)}
+	if p.Defaults, err = parseProfile(file.Defaults); err != nil {
+		return nil, fmt.Errorf("%s: defaults: %w", path, err)
+	}
+	for name, values := range file.Profiles {
+		if p.Profiles[name], err = parseProfile(values); err != nil {
+			return nil, fmt.Errorf("%s: profile %s: %w", path, name, err)
+		}
+	}
+	return p, nil
+}
+
+// ProjectPath returns the path of the configuration file of the
+// repository at baseDir.
+func ProjectPath(baseDir string) string {
+	return filepath.Join(baseDir, ProjectFile)
+}
+
+// Resolve returns the defaults overridden by the named profile, or the
+// defaults alone for "".
+func (p *Project) Resolve(name string) (Profile, error) {
+	resolved := Profile{Flags: make(map[string]string), Suites: make(map[string]map[string]string)}
+	resolved.merge(p.Defaults)
+	if name == "" {
+		return resolved, nil
+	}
+	profile, ok := p.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile: %s (available: %s)", name, strings.Join(p.ProfileNames(), ", "))
+	}
+	resolved.merge(profile)
+	return resolved, nil
+}
+
+// ProfileNames returns the names of the profiles, sorted.
+func (p *Project) ProfileNames() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// merge overrides the values of p with those of other
+func (p *Profile) merge(other Profile) {
+	for name, value := range other.Flags {
+		p.Flags[name] = value
+	}
+	for suiteName, flags := range other.Suites {
+		if p.Suites[suiteName] == nil {
+			p.Suites[suiteName] = make(map[string]string)
+		}
+		for name, value := range flags {
+			p.Suites[suiteName][name] = value
+		}
+	}
+}
+
+// parseProfile sorts the values of a profile into flags and suite
+// sections: a mapping is the flags of the suite it is named after
+func parseProfile(values map[string]any) (Profile, error) {
+	p := Profile{Flags: make(map[string]string), Suites: make(map[string]map[string]string)}
+	for name, value := range values {
+		section, ok := value.(map[string]any)
+		if !ok {
+			s, err := flagValue(value)
+			if err != nil {
+				return Profile{}, fmt.Errorf("%s: %w", name, err)
+			}
+			p.Flags[name] = s
+			continue
+		}
+		p.Suites[name] = make(map[string]string)
+		for flag, v := range section {
+			s, err := flagValue(v)
+			if err != nil {
+				return Profile{}, fmt.Errorf("%s.%s: %w", name, flag, err)
+			}
+			p.Suites[name][flag] = s
+		}
+	}
+	return p, nil
+}
+
+// flagValue converts a YAML value to its command-line form
+func flagValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int64, uint64:
+		return fmt.Sprint(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := flagValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, ","), nil
+	}
+	return "", fmt.Errorf("unsupported value %v", value)
+}
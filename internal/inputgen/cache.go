@@ -0,0 +1,147 @@
+package inputgen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Version is the version of the generators in this package. Cache names
+// include it, so inputs written by an older generator are regenerated
+// instead of reused; bump it whenever a generator's output changes.
+const Version = 1
+
+// Cache stores generated input files. Every file has a sidecar
+// <name>.sha256 in sha256sum format; a file is only reused while its
+// contents still match it.
+type Cache struct {
+	dir string
+}
+
+// New returns a cache storing its files under dir.
+func New(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// DefaultDir returns the per-user cache directory for generated inputs.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "benchrunner", "inputs"), nil
+}
+
+// Entry describes a cached input file.
+type Entry struct {
+	Path   string
+	SHA256 string
+	Size   int64
+	Cached bool // reused rather than generated by this call
+}
+
+// Get returns the file called name, calling generate to write it if it is
+// missing or its contents don't match its checksum. The file and its
+// checksum are written to temporary files and renamed into place, so
+// concurrent runs never observe a partial file.
+func (c *Cache) Get(name string, generate func(io.Writer) error) (*Entry, error) {
+	path := filepath.Join(c.dir, name)
+	if sum, err := readChecksum(path + ".sha256"); err == nil {
+		if actual, size, err := hashFile(path); err == nil && actual == sum {
+			return &Entry{Path: path, SHA256: sum, Size: size, Cached: true}, nil
+		}
+	}
+
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(c.dir, name+".tmp-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(tmp, h)}
+	if err := generate(counter); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("failed to generate %s: %w", name, err)
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(path+".sha256", []byte(sum+"  "+name+"\n")); err != nil {
+		return nil, err
+	}
+	return &Entry{Path: path, SHA256: sum, Size: counter.n}, nil
+}
+
+func readChecksum(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty checksum file %s", path)
+	}
+	return fields[0], nil
+}
+
+func hashFile(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
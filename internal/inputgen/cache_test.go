@@ -0,0 +1,101 @@
+package inputgen
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateYAMLDeterministic(t *testing.T) {
+	tests := []struct {
+		name         string
+		seedA, seedB int
+		wantSame     bool
+	}{
+		{name: "same seed", seedA: 7, seedB: 7, wantSame: true},
+		{name: "other seed", seedA: 7, seedB: 8, wantSame: false},
+	}
+	for _, tt := range tests {
+		var a, b bytes.Buffer
+		if err := GenerateYAML(&a, 100, tt.seedA); err != nil {
+			t.Fatal(err)
+		}
+		if err := GenerateYAML(&b, 100, tt.seedB); err != nil {
+			t.Fatal(err)
+		}
+		if same := bytes.Equal(a.Bytes(), b.Bytes()); same != tt.wantSame {
+			t.Errorf("%s: documents equal = %v, want %v", tt.name, same, tt.wantSame)
+		}
+	}
+	if err := GenerateYAML(io.Discard, 0, 1); err == nil {
+		t.Error("GenerateYAML with 0 rectangles succeeded")
+	}
+}
+
+func TestCacheGet(t *testing.T) {
+	c := New(t.TempDir())
+	calls := 0
+	generate := func(w io.Writer) error {
+		calls++
+		return GenerateYAML(w, 10, 1)
+	}
+
+	tests := []struct {
+		name       string
+		before     func(path string) // changes the cache before Get
+		wantCached bool
+	}{
+		{name: "missing", wantCached: false},
+		{name: "cached", wantCached: true},
+		{name: "corrupted file", before: func(path string) {
+			os.WriteFile(path, []byte("rectangles: []\n"), 0644)
+		}, wantCached: false},
+		{name: "missing checksum", before: func(path string) {
+			os.Remove(path + ".sha256")
+		}, wantCached: false},
+		{name: "cached again", wantCached: true},
+	}
+	var want []byte
+	for _, tt := range tests {
+		path := filepath.Join(c.dir, "in.yaml")
+		if tt.before != nil {
+			tt.before(path)
+		}
+		calls = 0
+		entry, err := c.Get("in.yaml", generate)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if entry.Cached != tt.wantCached || (calls == 0) != tt.wantCached {
+			t.Errorf("%s: Cached = %v after %d generate calls, want %v", tt.name, entry.Cached, calls, tt.wantCached)
+		}
+		got, err := os.ReadFile(entry.Path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if want == nil {
+			want = got
+		}
+		if !bytes.Equal(got, want) || entry.Size != int64(len(want)) {
+			t.Errorf("%s: cached file has %d bytes (entry: %d), want the %d generated", tt.name, len(got), entry.Size, len(want))
+		}
+	}
+
+	// a failed generation leaves nothing behind
+	errGenerate := errors.New("no input")
+	if _, err := c.Get("failed.yaml", func(io.Writer) error { return errGenerate }); !errors.Is(err, errGenerate) {
+		t.Errorf("Get with a failing generator = %v, want %v", err, errGenerate)
+	}
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if f.Name() != "in.yaml" && f.Name() != "in.yaml.sha256" {
+			t.Errorf("unexpected file %s left in the cache", f.Name())
+		}
+	}
+}
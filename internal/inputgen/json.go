@@ -0,0 +1,220 @@
+// Package inputgen generates the deterministic input documents benchmark
+// programs read. The same shape, size and seed always produce the same
+// bytes, so every language processes identical input.
+package inputgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// JSONShape describes one kind of generated JSON document.
+type JSONShape struct {
+	Name        string
+	Description string
+	SizeUnit    string // what the size of the document counts
+	DefaultSize int
+}
+
+// JSONShapes lists the JSON document shapes, in the order they are run.
+var JSONShapes = []JSONShape{
+	{"small-object", "API-style object with mixed field types", "fields", 16},
+	{"large-array", "array of flat records", "records", 20000},
+	{"nested", "deeply nested objects", "nesting depth", 100},
+}
+
+// Strings that exercise escapes and multi-byte UTF-8 in the decoders.
+var jsonTexts = []string{
+	"plain ascii text",
+	"quoted \"text\" with \\ backslash",
+	"line one\nline two\ttabbed",
+	"café crème brûlée",
+	"日本語のテキスト",
+	"emoji \U0001F600 and symbols ✓ §",
+}
+
+// GenerateJSON returns the JSON document of the given shape and size.
+// Numbers have at most two decimals so that checksums can sum them exactly
+// in hundredths.
+func GenerateJSON(shape string, size, seed int) ([]byte, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("invalid size for %s: %d", shape, size)
+	}
+	g := &jsonWriter{rng: newXorshift32(seed)}
+	switch shape {
+	case "small-object":
+		g.smallObject(size)
+	case "large-array":
+		g.largeArray(size)
+	case "nested":
+		g.nested(size)
+	default:
+		return nil, fmt.Errorf("unknown JSON shape: %s", shape)
+	}
+	return g.buf.Bytes(), nil
+}
+
+type jsonWriter struct {
+	buf bytes.Buffer
+	rng *xorshift32
+}
+
+func (g *jsonWriter) smallObject(fields int) {
+	g.buf.WriteByte('{')
+	for i := 0; i < fields; i++ {
+		if i > 0 {
+			g.buf.WriteByte(',')
+		}
+		switch i % 8 {
+		case 0:
+			g.key(fmt.Sprintf("id_%d", i))
+			g.int(g.rng.intn(1000000))
+		case 1:
+			g.key(fmt.Sprintf("name_%d", i))
+			g.string(fmt.Sprintf("user-%d", g.rng.intn(100000)))
+		case 2:
+			g.key(fmt.Sprintf("score_%d", i))
+			g.decimal(g.rng.intn(1000000))
+		case 3:
+			g.key(fmt.Sprintf("active_%d", i))
+			g.bool(g.rng.intn(2) == 1)
+		case 4:
+			g.key(fmt.Sprintf("parent_%d", i))
+			g.buf.WriteString("null")
+		case 5:
+			g.key(fmt.Sprintf("tags_%d", i))
+			g.buf.WriteByte('[')
+			for j := 0; j < 3; j++ {
+				if j > 0 {
+					g.buf.WriteByte(',')
+				}
+				g.string(fmt.Sprintf("tag-%d", g.rng.intn(50)))
+			}
+			g.buf.WriteByte(']')
+		case 6:
+			g.key(fmt.Sprintf("address_%d", i))
+			g.buf.WriteByte('{')
+			g.key("street")
+			g.string(fmt.Sprintf("%d Main Street", g.rng.intn(1000)))
+			g.buf.WriteByte(',')
+			g.key("zip")
+			g.int(10000 + g.rng.intn(90000))
+			g.buf.WriteByte('}')
+		case 7:
+			g.key(fmt.Sprintf("note_%d", i))
+			g.string(jsonTexts[g.rng.intn(len(jsonTexts))])
+		}
+	}
+	g.buf.WriteByte('}')
+}
+
+func (g *jsonWriter) largeArray(records int) {
+	g.buf.WriteByte('[')
+	for i := 0; i < records; i++ {
+		if i > 0 {
+			g.buf.WriteByte(',')
+		}
+		g.buf.WriteByte('{')
+		g.key("id")
+		g.int(i)
+		g.buf.WriteByte(',')
+		g.key("name")
+		g.string(fmt.Sprintf("item-%d", g.rng.intn(1000000)))
+		g.buf.WriteByte(',')
+		g.key("price")
+		g.decimal(g.rng.intn(100000))
+		g.buf.WriteByte(',')
+		g.key("in_stock")
+		g.bool(g.rng.intn(2) == 1)
+		g.buf.WriteByte(',')
+		g.key("tags")
+		g.buf.WriteString(`["sale","new"]`)
+		g.buf.WriteByte(',')
+		g.key("note")
+		if n := g.rng.intn(len(jsonTexts) + 1); n < len(jsonTexts) {
+			g.string(jsonTexts[n])
+		} else {
+			g.buf.WriteString("null")
+		}
+		g.buf.WriteByte('}')
+	}
+	g.buf.WriteByte(']')
+}
+
+// nested writes depth objects, each holding the next in its "child" field.
+// Containers are at most depth+1 levels deep.
+func (g *jsonWriter) nested(depth int) {
+	for i := 0; i < depth; i++ {
+		g.buf.WriteByte('{')
+		g.key("level")
+		g.int(i)
+		g.buf.WriteByte(',')
+		g.key("name")
+		g.string(fmt.Sprintf("node-%d", g.rng.intn(100000)))
+		g.buf.WriteByte(',')
+		g.key("weights")
+		g.buf.WriteByte('[')
+		for j := 0; j < 3; j++ {
+			if j > 0 {
+				g.buf.WriteByte(',')
+			}
+			g.decimal(g.rng.intn(10000))
+		}
+		g.buf.WriteByte(']')
+		g.buf.WriteByte(',')
+		g.key("child")
+	}
+	g.buf.WriteString("null")
+	for i := 0; i < depth; i++ {
+		g.buf.WriteByte('}')
+	}
+}
+
+func (g *jsonWriter) key(k string) {
+	g.string(k)
+	g.buf.WriteByte(':')
+}
+
+func (g *jsonWriter) string(s string) {
+	b, _ := json.Marshal(s)
+	g.buf.Write(b)
+}
+
+func (g *jsonWriter) int(n int) {
+	g.buf.WriteString(strconv.Itoa(n))
+}
+
+// decimal writes hundredths as a number with two decimals
+func (g *jsonWriter) decimal(hundredths int) {
+	fmt.Fprintf(&g.buf, "%d.%02d", hundredths/100, hundredths%100)
+}
+
+func (g *jsonWriter) bool(b bool) {
+	g.buf.WriteString(strconv.FormatBool(b))
+}
+
+// xorshift32 is the generator the compute programs use for their inputs.
+type xorshift32 struct {
+	state uint32
+}
+
+func newXorshift32(seed int) *xorshift32 {
+	s := uint32(seed)
+	if s == 0 {
+		s = 1
+	}
+	return &xorshift32{state: s}
+}
+
+func (x *xorshift32) next() uint32 {
+	x.state ^= x.state << 13
+	x.state ^= x.state >> 17
+	x.state ^= x.state << 5
+	return x.state
+}
+
+func (x *xorshift32) intn(n int) int {
+	return int(x.next() % uint32(n))
+}
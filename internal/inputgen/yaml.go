@@ -0,0 +1,62 @@
+package inputgen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+var yamlColors = []string{"red", "green", "blue", "orange", "purple", "teal"}
+
+// GenerateYAML writes a YAML document with the given number of rectangles
+// to w:
+//
+//	rectangles:
+//	  - id: 0
+//	    label: rect-0
+//	    a: 12.5
+//	    ...
+//	    style:
+//	      color: red
+//	      border:
+//	        width: 2
+//	        dash: [4, 2]
+//	    tags: [red, small]
+//
+// Coordinates are multiples of 0.5, so every area and their sum are exact
+// in float64 and print identically in every language.
+func GenerateYAML(w io.Writer, rectangles, seed int) error {
+	if rectangles < 1 {
+		return fmt.Errorf("invalid number of rectangles: %d", rectangles)
+	}
+	rng := newXorshift32(seed)
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "# generated by benchrunner: %d rectangles, seed %d\n", rectangles, seed)
+	bw.WriteString("rectangles:\n")
+	for i := 0; i < rectangles; i++ {
+		a, b := halves(rng, 2000), halves(rng, 2000)
+		c, d := a+halves(rng, 200), b+halves(rng, 200)
+
+		color := yamlColors[rng.intn(len(yamlColors))]
+		size := "small"
+		if (c-a)*(d-b) > 2500 {
+			size = "large"
+		}
+		fmt.Fprintf(bw, "  - id: %d\n", i)
+		fmt.Fprintf(bw, "    label: rect-%d\n", i)
+		fmt.Fprintf(bw, "    a: %g\n    b: %g\n    c: %g\n    d: %g\n", a, b, c, d)
+		bw.WriteString("    style:\n")
+		fmt.Fprintf(bw, "      color: %s\n", color)
+		bw.WriteString("      border:\n")
+		fmt.Fprintf(bw, "        width: %d\n", 1+rng.intn(4))
+		fmt.Fprintf(bw, "        dash: [%d, %d]\n", 1+rng.intn(8), 1+rng.intn(8))
+		fmt.Fprintf(bw, "    tags: [%s, %s]\n", color, size)
+	}
+	return bw.Flush()
+}
+
+// halves returns a random multiple of 0.5 in [0, max)
+func halves(rng *xorshift32, max int) float64 {
+	return float64(rng.intn(max*2)) / 2
+}
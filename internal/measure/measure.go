@@ -0,0 +1,240 @@
+package measure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Phases holds the wall-clock time of each phase of a single iteration.
+type Phases struct {
+	Compile time.Duration
+	Run     time.Duration
+	Total   time.Duration
+}
+
+// Stats summarises a set of durations, in milliseconds.
+type Stats struct {
+	Mean   float64 `json:"mean_ms"`
+	StdDev float64 `json:"stddev_ms"`
+	Median float64 `json:"median_ms"`
+	Min    float64 `json:"min_ms"`
+	Max    float64 `json:"max_ms"`
+}
+
+// ErrTimeout is returned (wrapped) when a command exceeds its timeout.
+var ErrTimeout = errors.New("timed out")
+
+// maxErrOutput caps how much command output is included in errors.
+const maxErrOutput = 4096
+
+// Shell runs cmdLine with sh -c in dir and returns its wall-clock time.
+// The command runs in its own process group with stdin closed; if it is
+// still running after timeout (when non-zero), the whole group is killed
+// and an error wrapping ErrTimeout is returned; likewise, once ctx is done
+// the group is killed and the error wraps ctx.Err(). Output is discarded
+// except for the tail included in the error on failure.
+func Shell(ctx context.Context, dir, cmdLine string, timeout time.Duration) (time.Duration, error) {
+	return shell(ctx, dir, cmdLine, timeout, nil)
+}
+
+// Output runs cmdLine like Shell and returns its standard output.
+func Output(ctx context.Context, dir, cmdLine string, timeout time.Duration) (string, error) {
+	_, output, err := TimedOutput(ctx, dir, cmdLine, timeout)
+	return output, err
+}
+
+// TimedOutput runs cmdLine like Shell and returns both its wall-clock time
+// and its standard output.
+func TimedOutput(ctx context.Context, dir, cmdLine string, timeout time.Duration) (time.Duration, string, error) {
+	var stdout bytes.Buffer
+	elapsed, err := shell(ctx, dir, cmdLine, timeout, &stdout)
+	return elapsed, stdout.String(), err
+}
+
+// shell runs cmdLine, additionally copying its standard output to stdout
+// when non-nil
+func shell(ctx context.Context, dir, cmdLine string, timeout time.Duration, stdout io.Writer) (time.Duration, error) {
+	cmd := exec.Command("sh", "-c", cmdLine)
+	cmd.Dir = dir
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if stdout != nil {
+		// Stdout and stderr are copied concurrently once they differ
+		combined := &lockedWriter{w: &output}
+		cmd.Stdout = io.MultiWriter(stdout, combined)
+		cmd.Stderr = combined
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+	err := WaitTimeout(ctx, cmd, timeout)
+	elapsed := time.Since(start)
+	if errors.Is(err, ErrTimeout) {
+		return elapsed, fmt.Errorf("%s: %w after %s", cmdLine, ErrTimeout, timeout)
+	}
+	if err != nil && err == ctx.Err() {
+		return elapsed, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+	if err != nil {
+		out := output.Bytes()
+		if len(out) > maxErrOutput {
+			out = out[len(out)-maxErrOutput:]
+		}
+		return elapsed, fmt.Errorf("%s: %w\n%s", cmdLine, err, strings.TrimSpace(string(out)))
+	}
+	return elapsed, nil
+}
+
+// lockedWriter serialises writes to w.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+// WaitTimeout waits for a started command. The command must have been
+// started in its own process group; if it doesn't exit within timeout (when
+// non-zero) the group is killed and ErrTimeout is returned. If ctx is done
+// first, the group is killed and ctx.Err() is returned.
+func WaitTimeout(ctx context.Context, cmd *exec.Cmd, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-deadline:
+		KillGroup(cmd.Process)
+		<-done
+		return ErrTimeout
+	case <-ctx.Done():
+		KillGroup(cmd.Process)
+		<-done
+		return ctx.Err()
+	}
+}
+
+// KillGroup kills the process group led by p (started with Setpgid),
+// falling back to p alone.
+func KillGroup(p *os.Process) {
+	if p == nil {
+		return
+	}
+	if err := syscall.Kill(-p.Pid, syscall.SIGKILL); err != nil {
+		p.Kill()
+	}
+}
+
+// Summarize computes statistics over durations. It returns a zero Stats for
+// an empty slice.
+func Summarize(durations []time.Duration) Stats {
+	if len(durations) == 0 {
+		return Stats{}
+	}
+	ms := make([]float64, len(durations))
+	for i, d := range durations {
+		ms[i] = float64(d.Nanoseconds()) / 1e6
+	}
+	return SummarizeValues(ms)
+}
+
+// SummarizeValues computes statistics over plain values.
+func SummarizeValues(values []float64) Stats {
+	if len(values) == 0 {
+		return Stats{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(len(sorted))
+
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - mean) * (v - mean)
+	}
+	stddev := 0.0
+	if len(sorted) > 1 {
+		stddev = math.Sqrt(variance / float64(len(sorted)-1))
+	}
+
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+	}
+
+	return Stats{
+		Mean:   mean,
+		StdDev: stddev,
+		Median: median,
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+	}
+}
+
+// tQuantiles are the 97.5% quantiles of Student's t distribution by
+// degrees of freedom, for two-sided 95% confidence intervals
+var tQuantiles = []struct {
+	df int
+	t  float64
+}{
+	{1, 12.706}, {2, 4.303}, {3, 3.182}, {4, 2.776}, {5, 2.571},
+	{6, 2.447}, {7, 2.365}, {8, 2.306}, {9, 2.262}, {10, 2.228},
+	{11, 2.201}, {12, 2.179}, {13, 2.160}, {14, 2.145}, {15, 2.131},
+	{16, 2.120}, {17, 2.110}, {18, 2.101}, {19, 2.093}, {20, 2.086},
+	{21, 2.080}, {22, 2.074}, {23, 2.069}, {24, 2.064}, {25, 2.060},
+	{26, 2.056}, {27, 2.052}, {28, 2.048}, {29, 2.045}, {30, 2.042},
+	{40, 2.021}, {60, 2.000}, {120, 1.980},
+}
+
+// CI95 returns the half-width of the 95% confidence interval of the mean of
+// n values with the sample standard deviation stddev, or 0 for fewer than
+// two values. Between and beyond the tabulated degrees of freedom the next
+// lower one is used, which errs on the wide side.
+func CI95(stddev float64, n int) float64 {
+	if n < 2 {
+		return 0
+	}
+	t := tQuantiles[0].t
+	for _, q := range tQuantiles {
+		if q.df <= n-1 {
+			t = q.t
+		}
+	}
+	return t * stddev / math.Sqrt(float64(n))
+}
+
+// String formats the stats as "mean ± stddev ms".
+func (s Stats) String() string {
+	return fmt.Sprintf("%.2f ± %.2f ms", s.Mean, s.StdDev)
+}
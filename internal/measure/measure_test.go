@@ -0,0 +1,142 @@
+package measure
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCI95(t *testing.T) {
+	tests := []struct {
+		n     int
+		wantT float64 // the t quantile used; 0 for no interval
+	}{
+		{n: 0, wantT: 0},
+		{n: 1, wantT: 0},
+		{n: 2, wantT: 12.706}, // df 1
+		{n: 10, wantT: 2.262}, // df 9
+		{n: 31, wantT: 2.042}, // df 30
+		// between tabulated degrees of freedom the next lower one is used:
+		// df 31..39 use df 30
+		{n: 32, wantT: 2.042},
+		{n: 35, wantT: 2.042},
+		{n: 40, wantT: 2.042},
+		{n: 41, wantT: 2.021}, // df 40
+		{n: 60, wantT: 2.021}, // df 59 uses df 40
+		{n: 61, wantT: 2.000}, // df 60
+		{n: 120, wantT: 2.000},
+		{n: 121, wantT: 1.980}, // df 120
+		// beyond the table the last entry is used
+		{n: 1000, wantT: 1.980},
+	}
+	for _, tt := range tests {
+		const stddev = 3.0
+		want := tt.wantT * stddev / math.Sqrt(float64(tt.n))
+		if tt.n == 0 {
+			want = 0
+		}
+		if got := CI95(stddev, tt.n); math.Abs(got-want) > 1e-9 {
+			t.Errorf("CI95(%v, %d) = %v, want %v (t = %v)", stddev, tt.n, got, want, tt.wantT)
+		}
+	}
+}
+
+func TestShell(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		cmdLine string
+		timeout time.Duration
+		wantErr error  // matched with errors.Is; nil: success
+		wantMsg string // part of the error message
+	}{
+		{name: "success", ctx: context.Background(), cmdLine: "true"},
+		{name: "success within timeout", ctx: context.Background(), cmdLine: "true", timeout: 10 * time.Second},
+		{name: "failure", ctx: context.Background(), cmdLine: "echo broken >&2; exit 3", wantMsg: "broken"},
+		{name: "timeout", ctx: context.Background(), cmdLine: "sleep 10", timeout: 100 * time.Millisecond, wantErr: ErrTimeout, wantMsg: "after 100ms"},
+		{name: "canceled", ctx: canceled, cmdLine: "sleep 10", wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		start := time.Now()
+		_, err := Shell(tt.ctx, t.TempDir(), tt.cmdLine, tt.timeout)
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s: Shell took %s", tt.name, elapsed)
+		}
+		if tt.wantErr == nil && tt.wantMsg == "" {
+			if err != nil {
+				t.Errorf("%s: Shell(%q): %v", tt.name, tt.cmdLine, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: Shell(%q) succeeded, want error", tt.name, tt.cmdLine)
+			continue
+		}
+		if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Shell(%q) = %v, want %v", tt.name, tt.cmdLine, err, tt.wantErr)
+		}
+		if !strings.Contains(err.Error(), tt.wantMsg) {
+			t.Errorf("%s: Shell(%q) = %v, want it to mention %q", tt.name, tt.cmdLine, err, tt.wantMsg)
+		}
+	}
+}
+
+func TestShellKillsProcessGroup(t *testing.T) {
+	// The background child would create the marker after the timeout
+	// unless it is killed with the shell
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cancel  time.Duration // cancel the context after this long; 0: never
+		wantErr error
+	}{
+		{name: "timeout", timeout: 200 * time.Millisecond, wantErr: ErrTimeout},
+		{name: "canceled", cancel: 200 * time.Millisecond, wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		ctx, cancel := context.WithCancel(context.Background())
+		if tt.cancel > 0 {
+			time.AfterFunc(tt.cancel, cancel)
+		}
+		_, err := Shell(ctx, dir, "(sleep 1; touch marker) & wait", tt.timeout)
+		cancel()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Shell = %v, want %v", tt.name, err, tt.wantErr)
+		}
+
+		time.Sleep(1500 * time.Millisecond)
+		if _, err := os.Stat(filepath.Join(dir, "marker")); err == nil {
+			t.Errorf("%s: background child outlived the shell", tt.name)
+		}
+	}
+}
+
+func TestTimedOutput(t *testing.T) {
+	tests := []struct {
+		cmdLine string
+		want    string
+		wantErr bool
+	}{
+		{cmdLine: "echo out; echo err >&2", want: "out\n"},
+		{cmdLine: "printf 'a\\nb'", want: "a\nb"},
+		{cmdLine: "echo partial; exit 1", want: "partial\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		_, got, err := TimedOutput(context.Background(), t.TempDir(), tt.cmdLine, 0)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("TimedOutput(%q) error = %v, want error %v", tt.cmdLine, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("TimedOutput(%q) = %q, want %q", tt.cmdLine, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package measure
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// StartupSample holds one launch of a program that follows the startup
+// protocol: its first line of output is "main <ns>", the wall-clock time
+// (Unix nanoseconds) at which its main function was entered, after which
+// it idles until its standard input is closed.
+type StartupSample struct {
+	TimeToMain        time.Duration // from spawn to the program's main
+	TimeToFirstOutput time.Duration // from spawn to its first output reaching the runner
+	IdleRSS           int64         // resident set size after idling, in KiB
+	PeakRSS           int64         // peak resident set size up to then, in KiB
+	CPUTime           time.Duration // user and system CPU time over the whole run
+}
+
+// Startup launches argv in dir and measures its startup. Leading
+// NAME=value words of argv are added to the environment, like a shell
+// would. Once the program reports its main, it is left idle for idle
+// before its current and peak RSS are read from /proc; then its stdin is
+// closed and its CPU time is taken from its resource usage. The program
+// runs directly rather than under sh, so the measurements are its own.
+//
+// The peak comes from /proc rather than rusage because ru_maxrss carries
+// over the runner's own pages from before exec.
+//
+// Once ctx is done, the program's process group is killed and the error
+// wraps ctx.Err().
+func Startup(ctx context.Context, dir string, argv []string, idle, timeout time.Duration) (StartupSample, error) {
+	env := os.Environ()
+	for len(argv) > 0 && strings.Contains(argv[0], "=") {
+		env = append(env, argv[0])
+		argv = argv[1:]
+	}
+	if len(argv) == 0 {
+		return StartupSample{}, fmt.Errorf("empty command")
+	}
+	cmdLine := strings.Join(argv, " ")
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return StartupSample{}, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return StartupSample{}, err
+	}
+
+	var sample StartupSample
+	spawned := time.Now()
+	if err := cmd.Start(); err != nil {
+		return sample, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+
+	type firstLine struct {
+		line    string
+		arrived time.Time
+		err     error
+	}
+	lines := make(chan firstLine, 1)
+	go func() {
+		r := bufio.NewReader(stdout)
+		line, err := r.ReadString('\n')
+		lines <- firstLine{line, time.Now(), err}
+		io.Copy(io.Discard, r)
+	}()
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	var first firstLine
+	select {
+	case first = <-lines:
+	case <-deadline:
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: %w after %s", cmdLine, ErrTimeout, timeout)
+	case <-ctx.Done():
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: %w", cmdLine, ctx.Err())
+	}
+	if first.err != nil {
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: no output: %w", cmdLine, first.err)
+	}
+	entered, err := parseMainLine(first.line)
+	if err != nil {
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+	sample.TimeToMain = entered.Sub(spawned)
+	sample.TimeToFirstOutput = first.arrived.Sub(spawned)
+
+	idleTimer := time.NewTimer(idle)
+	defer idleTimer.Stop()
+	select {
+	case <-idleTimer.C:
+	case <-ctx.Done():
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: %w", cmdLine, ctx.Err())
+	}
+	sample.IdleRSS, sample.PeakRSS, err = readRSS(cmd.Process.Pid)
+	if err != nil {
+		KillGroup(cmd.Process)
+		cmd.Wait()
+		return sample, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+
+	stdin.Close()
+	remaining := time.Duration(0)
+	if timeout > 0 {
+		remaining = timeout - time.Since(spawned)
+		if remaining <= 0 {
+			remaining = time.Millisecond
+		}
+	}
+	if err := WaitTimeout(ctx, cmd, remaining); err != nil {
+		return sample, fmt.Errorf("%s: %w", cmdLine, err)
+	}
+	sample.CPUTime = cmd.ProcessState.UserTime() + cmd.ProcessState.SystemTime()
+	return sample, nil
+}
+
+// parseMainLine parses the "main <ns>" line programs print on entering main
+func parseMainLine(line string) (time.Time, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2 || fields[0] != "main" {
+		return time.Time{}, fmt.Errorf("expected \"main <ns>\" as first output, got %q", strings.TrimSpace(line))
+	}
+	ns, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid main timestamp %q", fields[1])
+	}
+	return time.Unix(0, ns), nil
+}
+
+// readRSS returns the current (VmRSS) and peak (VmHWM) resident set size
+// of a process from /proc, in KiB
+func readRSS(pid int) (current, peak int64, err error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read RSS: %w", err)
+	}
+	values := make(map[string]int64)
+	for _, line := range strings.Split(string(data), "\n") {
+		key, rest, ok := strings.Cut(line, ":")
+		if !ok || (key != "VmRSS" && key != "VmHWM") {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		if v, err := strconv.ParseInt(fields[0], 10, 64); err == nil {
+			values[key] = v
+		}
+	}
+	current, ok1 := values["VmRSS"]
+	peak, ok2 := values["VmHWM"]
+	if !ok1 || !ok2 {
+		return 0, 0, fmt.Errorf("no VmRSS/VmHWM in /proc/%d/status", pid)
+	}
+	return current, peak, nil
+}
@@ -0,0 +1,130 @@
+// Package metrics parses the machine-readable metric lines benchmark
+// programs print next to their regular output, such as
+//
+//	METRIC fast_sum8=12.34 unit=ns
+//
+// Each line holds one metric: its name and value, optionally followed by
+// its unit.
+package metrics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/benchmarks/internal/measure"
+)
+
+// Prefix starts every metric line.
+const Prefix = "METRIC "
+
+// Metric is a single value reported by a program.
+type Metric struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit,omitempty"`
+}
+
+// Summary holds the statistics of a metric over several runs.
+type Summary struct {
+	Name   string  `json:"name"`
+	Unit   string  `json:"unit,omitempty"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stddev"`
+	Median float64 `json:"median"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	CI95   float64 `json:"ci95,omitempty"` // half-width of the 95% confidence interval of the mean
+	Runs   int     `json:"runs"`
+}
+
+// Parse returns the metrics reported in output. Malformed metric lines
+// are ignored.
+func Parse(output string) []Metric {
+	var metrics []Metric
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, Prefix) {
+			continue
+		}
+		var m Metric
+		valid := false
+		for _, field := range strings.Fields(line[len(Prefix):]) {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			if key == "unit" {
+				m.Unit = value
+				continue
+			}
+			if m.Name != "" {
+				continue
+			}
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				break
+			}
+			m.Name, m.Value, valid = key, v, true
+		}
+		if valid {
+			metrics = append(metrics, m)
+		}
+	}
+	return metrics
+}
+
+// Strip removes the metric lines from output, leaving the program's
+// regular output.
+func Strip(output string) string {
+	lines := strings.SplitAfter(output, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), Prefix) {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "")
+}
+
+// Samples accumulates the metrics of several runs of one program.
+type Samples struct {
+	names  []string
+	units  map[string]string
+	values map[string][]float64
+}
+
+// Add records the metrics of one run.
+func (s *Samples) Add(metrics []Metric) {
+	if s.values == nil {
+		s.units = make(map[string]string)
+		s.values = make(map[string][]float64)
+	}
+	for _, m := range metrics {
+		if _, ok := s.values[m.Name]; !ok {
+			s.names = append(s.names, m.Name)
+		}
+		s.units[m.Name] = m.Unit
+		s.values[m.Name] = append(s.values[m.Name], m.Value)
+	}
+}
+
+// Summarize returns the statistics of every metric, in the order the
+// metrics were first reported.
+func (s *Samples) Summarize() []Summary {
+	var summaries []Summary
+	for _, name := range s.names {
+		stats := measure.SummarizeValues(s.values[name])
+		summaries = append(summaries, Summary{
+			Name:   name,
+			Unit:   s.units[name],
+			Mean:   stats.Mean,
+			StdDev: stats.StdDev,
+			Median: stats.Median,
+			Min:    stats.Min,
+			Max:    stats.Max,
+			CI95:   measure.CI95(stats.StdDev, len(s.values[name])),
+			Runs:   len(s.values[name]),
+		})
+	}
+	return summaries
+}
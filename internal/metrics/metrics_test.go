@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []Metric
+	}{
+		{name: "no metrics", output: "hello\nworld\n", want: nil},
+		{name: "with unit", output: "METRIC fast_sum8=12.34 unit=ns\n", want: []Metric{{Name: "fast_sum8", Value: 12.34, Unit: "ns"}}},
+		{name: "without unit", output: "METRIC parse_time=5", want: []Metric{{Name: "parse_time", Value: 5}}},
+		{name: "unit first", output: "METRIC unit=ms kernel_time=1.5\n", want: []Metric{{Name: "kernel_time", Value: 1.5, Unit: "ms"}}},
+		{name: "indented", output: "  METRIC a=1 unit=ns  \r\n", want: []Metric{{Name: "a", Value: 1, Unit: "ns"}}},
+		{name: "mixed with output", output: "result: 42\nMETRIC a=1\nMETRIC b=-2e3 unit=ns\ndone\n", want: []Metric{{Name: "a", Value: 1}, {Name: "b", Value: -2000, Unit: "ns"}}},
+		{name: "only the first value counts", output: "METRIC a=1 b=2\n", want: []Metric{{Name: "a", Value: 1}}},
+		{name: "words without value skipped", output: "METRIC note a=3\n", want: []Metric{{Name: "a", Value: 3}}},
+
+		// malformed metric lines are ignored, the others still parsed
+		{name: "non-numeric value", output: "METRIC a=fast\nMETRIC b=2\n", want: []Metric{{Name: "b", Value: 2}}},
+		{name: "empty value", output: "METRIC a=\n", want: nil},
+		{name: "unit only", output: "METRIC unit=ns\n", want: nil},
+		{name: "no fields", output: "METRIC \nMETRIC\n", want: nil},
+		{name: "lowercase prefix", output: "metric a=1\n", want: nil},
+		{name: "prefix without space", output: "METRICa=1\n", want: nil},
+		{name: "prefix inside a line", output: "got METRIC a=1\n", want: nil},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.output); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Parse(%q) = %+v, want %+v", tt.name, tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{output: "", want: ""},
+		{output: "hello\n", want: "hello\n"},
+		{output: "hello\nMETRIC a=1 unit=ns\n", want: "hello\n"},
+		{output: "METRIC a=1\nhello\nMETRIC b=2", want: "hello\n"},
+		{output: "hello\n  METRIC a=1\nworld", want: "hello\nworld"},
+		// malformed metric lines are stripped too: they vary like the others
+		{output: "hello\nMETRIC a=fast\n", want: "hello\n"},
+		{output: "got METRIC a=1\n", want: "got METRIC a=1\n"},
+		{output: "METRICS: none\n", want: "METRICS: none\n"},
+	}
+	for _, tt := range tests {
+		if got := Strip(tt.output); got != tt.want {
+			t.Errorf("Strip(%q) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
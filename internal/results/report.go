@@ -0,0 +1,96 @@
+package results
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StatusSkipped marks suites of a report that did not run because the
+// targets select none of their variants.
+const StatusSkipped = "SKIPPED"
+
+// Report holds the outcome of several suites run in sequence.
+type Report struct {
+	Timestamp time.Time     `json:"timestamp"`
+	Duration  float64       `json:"duration_s"`
+	Targets   []string      `json:"targets,omitempty"`
+	Suites    []SuiteReport `json:"suites"`
+	Summary   Summary       `json:"summary"`
+}
+
+// SuiteReport is the section of a report for one suite: its status, why it
+// failed or was skipped, and every result it recorded.
+type SuiteReport struct {
+	Suite    string   `json:"suite"`
+	Status   string   `json:"status"` // OK, FAILED, INTERRUPTED or SKIPPED
+	Error    string   `json:"error,omitempty"`
+	Duration float64  `json:"duration_s"`
+	Results  []*Suite `json:"results"`
+}
+
+// Summary counts the suites and variants of a report by status and lists
+// what failed and what was skipped.
+type Summary struct {
+	Suites   map[string]int `json:"suites"`   // suites by status
+	Variants map[string]int `json:"variants"` // variant results by status
+	Failures []Failure      `json:"failures,omitempty"`
+	Skipped  []Failure      `json:"skipped,omitempty"`
+}
+
+// Failure is a suite, or a variant of one of its benchmarks, that did not
+// complete, and why.
+type Failure struct {
+	Suite     string `json:"suite"`
+	Benchmark string `json:"benchmark,omitempty"`
+	Variant   string `json:"variant,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Summarize fills in the summary of the report from its suites.
+func (r *Report) Summarize() {
+	sum := Summary{Suites: make(map[string]int), Variants: make(map[string]int)}
+	for _, s := range r.Suites {
+		sum.Suites[s.Status]++
+		switch s.Status {
+		case StatusSkipped:
+			sum.Skipped = append(sum.Skipped, Failure{Suite: s.Suite, Status: s.Status, Error: s.Error})
+		case StatusFailed, StatusInterrupted:
+			sum.Failures = append(sum.Failures, Failure{Suite: s.Suite, Status: s.Status, Error: s.Error})
+		}
+		for _, res := range s.Results {
+			for _, v := range res.Variants {
+				sum.Variants[v.Status]++
+				if v.Status != StatusOK {
+					sum.Failures = append(sum.Failures, Failure{
+						Suite:     s.Suite,
+						Benchmark: res.Suite,
+						Variant:   v.Name,
+						Status:    v.Status,
+						Error:     v.Error,
+					})
+				}
+			}
+		}
+	}
+	r.Summary = sum
+}
+
+// SaveReport writes the report as JSON into resultsDir and returns the
+// path of the written file.
+func SaveReport(resultsDir string, r *Report) (string, error) {
+	if err := os.MkdirAll(resultsDir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(resultsDir, "all_"+r.Timestamp.Format("20060102_150405")+".json")
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
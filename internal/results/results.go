@@ -0,0 +1,89 @@
+package results
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+)
+
+// Variant holds the outcome of one language variant within a suite run.
+type Variant struct {
+	Name             string            `json:"name"`
+	Status           string            `json:"status"`
+	Error            string            `json:"error,omitempty"`
+	IsolatedCaches   []string          `json:"isolated_caches,omitempty"`
+	Compile          *measure.Stats    `json:"compile,omitempty"`
+	CompileEstimated bool              `json:"compile_estimated,omitempty"`
+	Run              *measure.Stats    `json:"run,omitempty"`
+	Total            *measure.Stats    `json:"total,omitempty"`
+	BinarySize       int64             `json:"binary_size_bytes,omitempty"`
+	Metrics          []metrics.Summary `json:"metrics,omitempty"`
+	Rounds           []Round           `json:"rounds,omitempty"`
+}
+
+// Round holds the metrics of one round of a variant measured in rounds,
+// such as one load test of a server.
+type Round struct {
+	Round   int              `json:"round"` // from 1
+	Metrics []metrics.Metric `json:"metrics"`
+}
+
+// Suite holds the outcome of a suite run.
+type Suite struct {
+	Suite     string            `json:"suite"`
+	Mode      string            `json:"mode"`
+	Tool      string            `json:"tool,omitempty"`
+	Warmup    int               `json:"warmup"`
+	Runs      int               `json:"runs"`
+	Order     string            `json:"order,omitempty"`      // of the iterations, if not sequential
+	OrderSeed int64             `json:"order_seed,omitempty"` // seed of a random order
+	Params    map[string]string `json:"params,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+	Variants  []Variant         `json:"variants"`
+}
+
+// Status values recorded for variants. StatusInterrupted marks variants
+// that were cut short or never ran because the run was cancelled.
+const (
+	StatusOK          = "OK"
+	StatusFailed      = "FAILED"
+	StatusTimeout     = "TIMEOUT"
+	StatusInterrupted = "INTERRUPTED"
+)
+
+// Save writes the suite result as JSON into resultsDir and returns the
+// path of the written file. Parameters are part of the file name so that
+// runs of a sweep do not overwrite each other.
+func Save(resultsDir string, s *Suite) (string, error) {
+	if err := os.MkdirAll(resultsDir, 0755); err != nil {
+		return "", err
+	}
+
+	parts := []string{strings.ReplaceAll(s.Suite, "/", "_"), s.Mode}
+	keys := make([]string, 0, len(s.Params))
+	for k := range s.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		parts = append(parts, k+"-"+s.Params[k])
+	}
+	parts = append(parts, s.Timestamp.Format("20060102_150405"))
+	filename := strings.Join(parts, "_") + ".json"
+	path := filepath.Join(resultsDir, filename)
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"os/exec"
@@ -14,10 +16,13 @@ import (
 type Server struct {
 	config *config.ServerConfig
 	cmd    *exec.Cmd
+	log    io.Writer
 }
 
-func New(cfg *config.ServerConfig) *Server {
-	return &Server{config: cfg}
+// New returns the server of cfg. Its progress and the standard output of
+// the server process go to log.
+func New(cfg *config.ServerConfig, log io.Writer) *Server {
+	return &Server{config: cfg, log: log}
 }
 
 func (s *Server) GetPID() int {
@@ -27,12 +32,15 @@ func (s *Server) GetPID() int {
 	return -1
 }
 
-func (s *Server) Start() error {
-	fmt.Printf("Starting %s...\n", s.config.Name)
+// Start starts the server in its own process group and waits until it
+// accepts connections. If ctx is done before that, the server is stopped
+// and the error wraps ctx.Err().
+func (s *Server) Start(ctx context.Context) error {
+	fmt.Fprintf(s.log, "Starting %s...\n", s.config.Name)
 
 	s.cmd = exec.Command(s.config.StartCmd[0], s.config.StartCmd[1:]...)
 	s.cmd.Dir = s.config.Dir
-	s.cmd.Stdout = os.Stdout
+	s.cmd.Stdout = s.log
 	s.cmd.Stderr = os.Stderr
 	// Start process in its own process group so we can kill all children
 	s.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
@@ -42,13 +50,13 @@ func (s *Server) Start() error {
 	}
 
 	// Wait for server to be ready
-	fmt.Printf("Waiting for %s to be ready...\n", s.config.Name)
-	if err := s.waitForServer(5 * time.Second); err != nil {
+	fmt.Fprintf(s.log, "Waiting for %s to be ready...\n", s.config.Name)
+	if err := s.waitForServer(ctx, 5*time.Second); err != nil {
 		s.Stop()
 		return fmt.Errorf("%s failed to start: %w", s.config.Name, err)
 	}
 
-	fmt.Printf("%s is ready\n", s.config.Name)
+	fmt.Fprintf(s.log, "%s is ready\n", s.config.Name)
 	return nil
 }
 
@@ -57,7 +65,7 @@ func (s *Server) Stop() error {
 		return nil
 	}
 
-	fmt.Printf("Stopping %s...\n", s.config.Name)
+	fmt.Fprintf(s.log, "Stopping %s...\n", s.config.Name)
 
 	// Special handling for nginx
 	if s.config.Name == "nginx-static" {
@@ -83,7 +91,7 @@ func (s *Server) Stop() error {
 	return nil
 }
 
-func (s *Server) waitForServer(timeout time.Duration) error {
+func (s *Server) waitForServer(ctx context.Context, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
 	addr := fmt.Sprintf("localhost:%d", s.config.Port)
 
@@ -93,7 +101,11 @@ func (s *Server) waitForServer(timeout time.Duration) error {
 			conn.Close()
 			return nil
 		}
-		time.Sleep(200 * time.Millisecond)
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 
 	return fmt.Errorf("timeout waiting for server on port %d", s.config.Port)
@@ -0,0 +1,101 @@
+package suite
+
+import (
+	"fmt"
+)
+
+// Plan describes what a suite run would execute, as resolved from its
+// configuration: the variants it selects and skips, and every command it
+// runs in every phase. Making a plan executes nothing.
+type Plan struct {
+	Suite      string          `json:"suite"`
+	Benchmarks []PlanBenchmark `json:"benchmarks"`
+
+	// Skipped is why a run of several suites would skip the suite
+	Skipped string `json:"skipped,omitempty"`
+}
+
+// PlanBenchmark is one benchmark of a plan: the suite itself, or one of its
+// sub-benchmarks at one set of parameters.
+type PlanBenchmark struct {
+	Name   string            `json:"name"`
+	Params map[string]string `json:"params,omitempty"`
+	Mode   string            `json:"mode"`
+	Tool   string            `json:"tool"`            // poop, hyperfine, builtin, or the suite's own measurement
+	Order  string            `json:"order,omitempty"` // of the iterations of the variants, if they repeat
+
+	Inputs   []string      `json:"inputs,omitempty"` // files generated before the variants run
+	Variants []PlanVariant `json:"variants"`
+	Skipped  []PlanSkip    `json:"skipped,omitempty"`
+
+	// ToolCommand is the benchmark tool invocation that times the
+	// variants, if the benchmark uses one
+	ToolCommand []string `json:"tool_command,omitempty"`
+}
+
+// PlanVariant is a selected variant and the commands run for it.
+type PlanVariant struct {
+	Name   string     `json:"name"`
+	Source string     `json:"source,omitempty"` // copied into Dir before anything runs
+	Dir    string     `json:"dir"`              // where the commands run
+	Env    []string   `json:"env,omitempty"`    // added to the environment of its commands
+	Steps  []PlanStep `json:"steps"`
+}
+
+// PlanStep is a command run in a phase, possibly several times.
+type PlanStep struct {
+	Phase   string `json:"phase"`   // PhasePrepare, PhaseVerify or PhaseRun
+	Action  string `json:"action"`  // what the command is for, e.g. "compile"
+	Command string `json:"command"` // shell command line
+	Timeout string `json:"timeout,omitempty"`
+	Repeat  int    `json:"repeat,omitempty"` // how often it runs, if more than once
+}
+
+// PlanSkip is a variant that won't run, and why.
+type PlanSkip struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// MakePlan resolves the plan of the named suite for cfg. Like Execute, it
+// fails if the targets select none of the suite's variants.
+func MakePlan(name string, s Suite, cfg *Config) (*Plan, error) {
+	cfg.Suite = name
+	cfg.defaultLog()
+	if err := cfg.checkOrder(); err != nil {
+		return nil, err
+	}
+	if err := checkTargets(name, s, cfg); err != nil {
+		return nil, err
+	}
+	benchmarks, err := s.Plan(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{Suite: name, Benchmarks: benchmarks}, nil
+}
+
+// NoVariantsError is the error of a run or plan whose targets select none
+// of the suite's variants.
+type NoVariantsError struct {
+	Suite     string
+	Selection string // the targets and selectors, as Config.Selection describes them
+}
+
+func (e *NoVariantsError) Error() string {
+	return fmt.Sprintf("no %s variants match %s", e.Suite, e.Selection)
+}
+
+// checkTargets fails if cfg's targets or selectors are invalid, or select
+// none of the suite's variants
+func checkTargets(name string, s Suite, cfg *Config) error {
+	if _, err := cfg.parseSelection(); err != nil {
+		return err
+	}
+	for _, v := range s.Variants() {
+		if cfg.Matches(v) {
+			return nil
+		}
+	}
+	return &NoVariantsError{Suite: name, Selection: cfg.Selection()}
+}
@@ -0,0 +1,132 @@
+package suite
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/spf13/pflag"
+)
+
+// Flags selects the shared options a suite takes on its command line.
+type Flags uint
+
+const (
+	// RunFlags are --warmup and --runs.
+	RunFlags Flags = 1 << iota
+	// ModeFlags are --mode, --tool and --keep-artifacts.
+	ModeFlags
+	// BuildFlags are --jobs and --no-build-cache.
+	BuildFlags
+	// CompileTimeoutFlag is --compile-timeout.
+	CompileTimeoutFlag
+	// PrepareTimeoutFlag is --prepare-timeout.
+	PrepareTimeoutFlag
+	// RunTimeoutFlag is --run-timeout.
+	RunTimeoutFlag
+	// OrderFlags are --order and --order-seed.
+	OrderFlags
+
+	// ProgramFlags are the flags of suites that compile and run programs
+	// in every mode.
+	ProgramFlags = RunFlags | ModeFlags | BuildFlags | CompileTimeoutFlag | PrepareTimeoutFlag | RunTimeoutFlag | OrderFlags
+)
+
+// Flagger is implemented by suites that have flags of their own. The
+// flags are bound to the suite the command runs.
+type Flagger interface {
+	Flags(fs *pflag.FlagSet)
+}
+
+// Registration describes a suite to the registry.
+type Registration struct {
+	Name  string
+	Args  string // usage of the positional arguments; default "[language]"
+	Short string
+	Long  string
+	Flags Flags
+
+	// New returns a suite for the repository at baseDir.
+	New func(baseDir string) Suite
+}
+
+var (
+	mu       sync.Mutex
+	registry = make(map[string]Registration)
+)
+
+// Register adds a suite to the registry. Suites register themselves from
+// init, so registering a name twice is a programming error and panics.
+func Register(r Registration) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := registry[r.Name]; dup {
+		panic(fmt.Sprintf("suite: %s registered twice", r.Name))
+	}
+	registry[r.Name] = r
+}
+
+// All returns every registered suite, sorted by name.
+func All() []Registration {
+	mu.Lock()
+	defer mu.Unlock()
+	all := make([]Registration, 0, len(registry))
+	for _, r := range registry {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all
+}
+
+// Lookup returns the registered suite with the given name.
+func Lookup(name string) (Registration, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	r, ok := registry[name]
+	return r, ok
+}
+
+// BindFlags adds the shared flags selected by flags to fs, bound to opts,
+// and --select and --exclude, which every suite takes. The current values
+// of opts are the defaults.
+func BindFlags(fs *pflag.FlagSet, flags Flags, opts *Options) {
+	// Builds are only done up front in exec mode for suites that have modes
+	buildScope := ""
+	if flags&ModeFlags != 0 {
+		buildScope = " (exec mode)"
+	}
+
+	if flags&RunFlags != 0 {
+		fs.IntVarP(&opts.Warmup, "warmup", "w", opts.Warmup, "Number of warmup runs")
+		fs.IntVarP(&opts.Runs, "runs", "r", opts.Runs, "Number of benchmark runs")
+	}
+	if flags&ModeFlags != 0 {
+		fs.StringVarP(&opts.Mode, "mode", "m", opts.Mode, "Benchmark mode: compile, full-cold, full-hot, exec")
+		fs.StringVar(&opts.KeepDir, "keep-artifacts", opts.KeepDir, "Copy built artifacts into this directory before cleanup")
+	}
+	if flags&BuildFlags != 0 {
+		fs.IntVarP(&opts.Jobs, "jobs", "j", opts.Jobs, "Number of variants to pre-compile concurrently"+buildScope)
+		fs.BoolVar(&opts.NoBuildCache, "no-build-cache", opts.NoBuildCache, "Always recompile instead of reusing cached binaries"+buildScope)
+	}
+	if flags&CompileTimeoutFlag != 0 {
+		fs.DurationVar(&opts.CompileTimeout, "compile-timeout", opts.CompileTimeout, "Timeout for each compile step")
+	}
+	if flags&PrepareTimeoutFlag != 0 {
+		fs.DurationVar(&opts.PrepareTimeout, "prepare-timeout", opts.PrepareTimeout, "Timeout for each prepare (clean) step")
+	}
+	if flags&RunTimeoutFlag != 0 {
+		fs.DurationVar(&opts.RunTimeout, "run-timeout", opts.RunTimeout, "Timeout for each run step")
+	}
+	if flags&OrderFlags != 0 {
+		fs.StringVar(&opts.Order, "order", opts.Order, "Order of the iterations of the variants: sequential, interleaved, random")
+		fs.Int64Var(&opts.OrderSeed, "order-seed", opts.OrderSeed, "Seed of --order random (default: picked per run and recorded with the results)")
+	}
+	fs.StringArrayVar(&opts.Select, "select", opts.Select,
+		"Run only variants matching this selector, e.g. 'lang=rust,build=direct' (repeatable: any of them)")
+	fs.StringArrayVar(&opts.Exclude, "exclude", opts.Exclude,
+		"Skip variants matching this selector, e.g. 'nodets-*' (repeatable)")
+	if flags&ModeFlags != 0 {
+		fs.StringVar(&opts.Tool, "tool", opts.Tool, "Benchmark tool for compile/exec modes: auto, poop, hyperfine, builtin")
+		fs.IntVar(&opts.MetricRuns, "metric-runs", opts.MetricRuns, "Number of runs collecting reported metrics after poop or hyperfine (exec mode)")
+	}
+}
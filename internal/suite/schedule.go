@@ -0,0 +1,77 @@
+package suite
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Orders in which the iterations of the variants of a benchmark run.
+// Running every iteration of one variant before the next lets drift over
+// time (thermal throttling, background load) bias the variants that run
+// later; interleaving spreads it over all of them.
+const (
+	// OrderSequential runs every iteration of a variant before the next
+	// variant: AAA BBB.
+	OrderSequential = "sequential"
+	// OrderInterleaved runs one iteration of every variant per round:
+	// ABAB.
+	OrderInterleaved = "interleaved"
+	// OrderRandom runs one iteration of every variant per round, in an
+	// order shuffled anew for every round from the seed: AB BA.
+	OrderRandom = "random"
+)
+
+// checkOrder validates the order of the options
+func (o Options) checkOrder() error {
+	switch o.Order {
+	case OrderSequential, OrderInterleaved, OrderRandom:
+		return nil
+	}
+	return fmt.Errorf("invalid order: %s (valid: %s, %s, %s)", o.Order, OrderSequential, OrderInterleaved, OrderRandom)
+}
+
+// seedOrder picks the seed of a random order if none was given, so that
+// the run can be reproduced from the seed recorded with its results
+func (o *Options) seedOrder() {
+	if o.Order == OrderRandom && o.OrderSeed == 0 {
+		o.OrderSeed = time.Now().UnixNano()
+	}
+}
+
+// Schedule returns the order in which the iterations of n variants run,
+// each iteration times: the index of the variant of every iteration, in
+// order. The k-th occurrence of a variant is its k-th iteration.
+func (o Options) Schedule(n, iterations int) []int {
+	schedule := make([]int, 0, n*iterations)
+	if o.Order == OrderSequential {
+		for v := 0; v < n; v++ {
+			for i := 0; i < iterations; i++ {
+				schedule = append(schedule, v)
+			}
+		}
+		return schedule
+	}
+
+	rng := rand.New(rand.NewSource(o.OrderSeed))
+	round := make([]int, n)
+	for i := 0; i < iterations; i++ {
+		for v := range round {
+			round[v] = v
+		}
+		if o.Order == OrderRandom {
+			rng.Shuffle(n, func(a, b int) { round[a], round[b] = round[b], round[a] })
+		}
+		schedule = append(schedule, round...)
+	}
+	return schedule
+}
+
+// DescribeOrder describes the order of the options, with the seed of a
+// random order once it is known: "random (seed 42)".
+func (o Options) DescribeOrder() string {
+	if o.Order == OrderRandom && o.OrderSeed != 0 {
+		return fmt.Sprintf("%s (seed %d)", o.Order, o.OrderSeed)
+	}
+	return o.Order
+}
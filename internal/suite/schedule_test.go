@@ -0,0 +1,82 @@
+package suite
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		order         string
+		n, iterations int
+		want          []int
+	}{
+		{order: OrderSequential, n: 2, iterations: 3, want: []int{0, 0, 0, 1, 1, 1}},
+		{order: OrderInterleaved, n: 2, iterations: 3, want: []int{0, 1, 0, 1, 0, 1}},
+		{order: OrderInterleaved, n: 3, iterations: 1, want: []int{0, 1, 2}},
+		{order: OrderSequential, n: 0, iterations: 3, want: []int{}},
+		{order: OrderInterleaved, n: 2, iterations: 0, want: []int{}},
+	}
+	for _, tt := range tests {
+		got := Options{Order: tt.order}.Schedule(tt.n, tt.iterations)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s Schedule(%d, %d) = %v, want %v", tt.order, tt.n, tt.iterations, got, tt.want)
+		}
+	}
+}
+
+func TestScheduleRandomSeed(t *testing.T) {
+	const n, iterations = 5, 20
+
+	tests := []struct {
+		name         string
+		seedA, seedB int64
+		wantSame     bool
+	}{
+		{name: "same seed", seedA: 42, seedB: 42, wantSame: true},
+		{name: "other seed", seedA: 42, seedB: 43, wantSame: false},
+		{name: "negative seed", seedA: -7, seedB: -7, wantSame: true},
+	}
+	for _, tt := range tests {
+		a := Options{Order: OrderRandom, OrderSeed: tt.seedA}.Schedule(n, iterations)
+		b := Options{Order: OrderRandom, OrderSeed: tt.seedB}.Schedule(n, iterations)
+		if same := reflect.DeepEqual(a, b); same != tt.wantSame {
+			t.Errorf("%s: schedules equal = %v, want %v\n%v\n%v", tt.name, same, tt.wantSame, a, b)
+		}
+
+		// every round runs every variant once
+		for round := 0; round < iterations; round++ {
+			seen := make([]bool, n)
+			for _, v := range a[round*n : (round+1)*n] {
+				if seen[v] {
+					t.Fatalf("%s: round %d runs variant %d twice: %v", tt.name, round, v, a)
+				}
+				seen[v] = true
+			}
+		}
+	}
+}
+
+func TestSeedOrder(t *testing.T) {
+	tests := []struct {
+		order    string
+		seed     int64
+		wantSeed bool // a seed is set afterwards
+		keep     bool // the given seed is kept
+	}{
+		{order: OrderRandom, seed: 0, wantSeed: true},
+		{order: OrderRandom, seed: 42, wantSeed: true, keep: true},
+		{order: OrderInterleaved, seed: 0, wantSeed: false},
+		{order: OrderSequential, seed: 0, wantSeed: false},
+	}
+	for _, tt := range tests {
+		o := Options{Order: tt.order, OrderSeed: tt.seed}
+		o.seedOrder()
+		if (o.OrderSeed != 0) != tt.wantSeed {
+			t.Errorf("%s seed %d: seedOrder set seed %d", tt.order, tt.seed, o.OrderSeed)
+		}
+		if tt.keep && o.OrderSeed != tt.seed {
+			t.Errorf("%s seed %d: seedOrder replaced it with %d", tt.order, tt.seed, o.OrderSeed)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package suite
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// A selector picks variants by name and tags. It is a comma-separated list
+// of terms that must all hold:
+//
+//	key=value   the variant's tag key has the value ("lang=rust")
+//	key!=value  it doesn't ("build!=cmake")
+//	word        the variant is named word, word is a dash-separated
+//	            prefix of its name ("rust" selects "rust-cargo") or word
+//	            is the value of one of its tags ("node", "cargo")
+//
+// Values and words may be glob patterns ("nodets-*", "lang=c*").
+type selector []term
+
+type term struct {
+	key, value string // key is empty for a word
+	negate     bool
+}
+
+// parseSelector parses a selector
+func parseSelector(s string) (selector, error) {
+	var sel selector
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(strings.ToLower(part))
+		if part == "" {
+			continue
+		}
+		var t term
+		if key, value, ok := strings.Cut(part, "="); ok {
+			t = term{key: strings.TrimSpace(key), value: strings.TrimSpace(value)}
+			if strings.HasSuffix(t.key, "!") {
+				t.key, t.negate = strings.TrimSpace(strings.TrimSuffix(t.key, "!")), true
+			}
+			if t.key == "" {
+				return nil, fmt.Errorf("invalid selector %q: missing tag name", s)
+			}
+		} else {
+			t = term{value: part}
+		}
+		if _, err := path.Match(t.value, ""); err != nil {
+			return nil, fmt.Errorf("invalid selector %q: bad pattern %q", s, t.value)
+		}
+		sel = append(sel, t)
+	}
+	if len(sel) == 0 {
+		return nil, fmt.Errorf("empty selector")
+	}
+	return sel, nil
+}
+
+// matches reports whether every term of the selector holds for v
+func (sel selector) matches(v Variant) bool {
+	for _, t := range sel {
+		if !t.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t term) matches(v Variant) bool {
+	if t.key != "" {
+		value, ok := v.Tags[t.key]
+		return ok && glob(t.value, value) != t.negate
+	}
+	name := strings.ToLower(v.Name)
+	if glob(t.value, name) || strings.HasPrefix(name, t.value+"-") || strings.HasPrefix(name, t.value+"_") {
+		return true
+	}
+	for _, value := range v.Tags {
+		if glob(t.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// glob reports whether s matches pattern, which may be a plain string
+func glob(pattern, s string) bool {
+	ok, _ := path.Match(pattern, s)
+	return ok
+}
+
+// selection holds the parsed targets, --select and --exclude of a Config
+type selection struct {
+	targets []selector // any of them; each target is a single term
+	include []selector // any of them
+	exclude []selector // none of them
+}
+
+// parseSelection parses the targets and selectors of c
+func (c *Config) parseSelection() (selection, error) {
+	var sel selection
+	for _, arg := range c.Targets {
+		for _, target := range strings.Split(arg, ",") {
+			if strings.TrimSpace(target) == "" {
+				continue
+			}
+			s, err := parseSelector(target)
+			if err != nil {
+				return selection{}, err
+			}
+			sel.targets = append(sel.targets, s)
+		}
+	}
+	for _, list := range []struct {
+		args []string
+		to   *[]selector
+	}{{c.Select, &sel.include}, {c.Exclude, &sel.exclude}} {
+		for _, arg := range list.args {
+			s, err := parseSelector(arg)
+			if err != nil {
+				return selection{}, err
+			}
+			*list.to = append(*list.to, s)
+		}
+	}
+	return sel, nil
+}
+
+// excludes reports whether one of the --exclude selectors matches v
+func (sel selection) excludes(v Variant) bool {
+	return anySelector(sel.exclude, v)
+}
+
+// includes reports whether v passes the targets and --select selectors
+func (sel selection) includes(v Variant) bool {
+	return (len(sel.targets) == 0 || anySelector(sel.targets, v)) &&
+		(len(sel.include) == 0 || anySelector(sel.include, v))
+}
+
+func anySelector(sels []selector, v Variant) bool {
+	for _, s := range sels {
+		if s.matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether the variant is selected: it matches one of the
+// targets, if any, and one of the --select selectors, if any, and none of
+// the --exclude selectors. Targets are single selector terms and may be
+// comma-separated ("go,rust"). Invalid selectors match nothing; Execute
+// reports them.
+func (c *Config) Matches(v Variant) bool {
+	sel, err := c.parseSelection()
+	if err != nil {
+		return false
+	}
+	return sel.includes(v) && !sel.excludes(v)
+}
+
+// NotSelected is the reason recorded for a variant the configuration
+// doesn't select.
+func (c *Config) NotSelected(v Variant) string {
+	sel, err := c.parseSelection()
+	if err == nil && sel.excludes(v) {
+		return "excluded by --exclude " + strings.Join(c.Exclude, " --exclude ")
+	}
+	return "not selected by " + c.Selection()
+}
+
+// Selection describes the targets and selectors of c, e.g.
+// "go,rust --select lang=rust".
+func (c *Config) Selection() string {
+	var parts []string
+	if len(c.Targets) > 0 {
+		parts = append(parts, strings.Join(c.Targets, " "))
+	}
+	for _, s := range c.Select {
+		parts = append(parts, "--select "+s)
+	}
+	for _, s := range c.Exclude {
+		parts = append(parts, "--exclude "+s)
+	}
+	return strings.Join(parts, " ")
+}
+
+// FormatTags formats tags as "key=value" pairs sorted by key.
+func FormatTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+tags[key])
+	}
+	return strings.Join(pairs, ",")
+}
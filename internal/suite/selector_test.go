@@ -0,0 +1,104 @@
+package suite
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    selector
+		wantErr bool
+	}{
+		{in: "rust", want: selector{{value: "rust"}}},
+		{in: " Rust ", want: selector{{value: "rust"}}},
+		{in: "lang=rust", want: selector{{key: "lang", value: "rust"}}},
+		{in: "build!=cmake", want: selector{{key: "build", value: "cmake", negate: true}}},
+		{in: "build != cmake", want: selector{{key: "build", value: "cmake", negate: true}}},
+		{in: "nodets-*", want: selector{{value: "nodets-*"}}},
+		{in: "lang=c*", want: selector{{key: "lang", value: "c*"}}},
+		{in: "lang=rust,build!=cargo", want: selector{{key: "lang", value: "rust"}, {key: "build", value: "cargo", negate: true}}},
+		{in: "go,,rust", want: selector{{value: "go"}, {value: "rust"}}},
+		{in: "", wantErr: true},
+		{in: " , ", wantErr: true},
+		{in: "=rust", wantErr: true},
+		{in: "!=rust", wantErr: true},
+		{in: "lang=[", wantErr: true},
+		{in: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseSelector(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseSelector(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseSelector(%q): %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSelector(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTermMatches(t *testing.T) {
+	rustCargo := Variant{Name: "rust-cargo", Tags: map[string]string{"lang": "rust", "build": "cargo"}}
+	cppCmake := Variant{Name: "cpp_cmake", Tags: map[string]string{"lang": "cpp", "build": "cmake"}}
+	nodets := Variant{Name: "nodets-esbuild", Tags: map[string]string{"lang": "typescript", "runtime": "node"}}
+	untagged := Variant{Name: "Go"}
+
+	tests := []struct {
+		sel  string
+		v    Variant
+		want bool
+	}{
+		// words: the name, a dash- or underscore-separated prefix, a tag value
+		{sel: "rust-cargo", v: rustCargo, want: true},
+		{sel: "rust", v: rustCargo, want: true},
+		{sel: "rus", v: rustCargo, want: false},
+		{sel: "cargo", v: rustCargo, want: true},
+		{sel: "cpp", v: cppCmake, want: true},
+		{sel: "cmake", v: cppCmake, want: true},
+		{sel: "node", v: nodets, want: true},
+		{sel: "nodets", v: nodets, want: true},
+		{sel: "esbuild", v: nodets, want: false},
+		{sel: "go", v: untagged, want: true},
+		{sel: "rust", v: cppCmake, want: false},
+
+		// globs on names and tag values
+		{sel: "nodets-*", v: nodets, want: true},
+		{sel: "nodets-*", v: rustCargo, want: false},
+		{sel: "*-cargo", v: rustCargo, want: true},
+		{sel: "c*", v: cppCmake, want: true},
+		{sel: "lang=c*", v: cppCmake, want: true},
+		{sel: "lang=c*", v: rustCargo, want: false},
+		{sel: "lang=?ust", v: rustCargo, want: true},
+
+		// key=value and negation
+		{sel: "lang=rust", v: rustCargo, want: true},
+		{sel: "lang=rust", v: cppCmake, want: false},
+		{sel: "build!=cmake", v: rustCargo, want: true},
+		{sel: "build!=cmake", v: cppCmake, want: false},
+		{sel: "build!=c*", v: cppCmake, want: false},
+		{sel: "build!=cmake", v: nodets, want: false}, // no build tag to compare
+		{sel: "lang=rust", v: untagged, want: false},
+
+		// every term must hold
+		{sel: "lang=rust,build=cargo", v: rustCargo, want: true},
+		{sel: "lang=rust,build=cmake", v: rustCargo, want: false},
+		{sel: "rust,build!=cargo", v: rustCargo, want: false},
+	}
+	for _, tt := range tests {
+		sel, err := parseSelector(tt.sel)
+		if err != nil {
+			t.Fatalf("parseSelector(%q): %v", tt.sel, err)
+		}
+		if got := sel.matches(tt.v); got != tt.want {
+			t.Errorf("%q matches %s = %v, want %v", tt.sel, tt.v.Name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package suite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+)
+
+// Variant is one implementation a suite benchmarks, e.g. a language with
+// a particular build or runtime configuration.
+type Variant struct {
+	Name string
+	Tags map[string]string // e.g. lang=rust, build=cargo; see the selector syntax
+}
+
+// Suite is a benchmark suite. Execute drives a suite through its phases:
+// Prepare, Verify and Run in that order, stopping at the first error, and
+// Cleanup whatever happened before. Variants that fail a phase are
+// recorded as failed and left out of the later phases; an error from a
+// phase means the suite as a whole could not go on.
+type Suite interface {
+	// Variants lists every variant of the suite, selected or not.
+	Variants() []Variant
+	// Prepare sets up the selected variants: workspaces, builds, inputs.
+	Prepare(ctx context.Context, cfg *Config) error
+	// Verify checks the prepared variants before anything is timed, e.g.
+	// against the output of a reference implementation.
+	Verify(ctx context.Context, cfg *Config) error
+	// Run times the variants and hands every result to cfg.Record. When
+	// ctx is done, it records the variants it cut short as interrupted.
+	Run(ctx context.Context, cfg *Config) error
+	// Cleanup removes everything Prepare created. It is called even if
+	// Prepare failed halfway or the run was cancelled, with a context that
+	// is not.
+	Cleanup(ctx context.Context, cfg *Config) error
+	// Plan describes what Prepare, Verify and Run would execute for cfg,
+	// without executing or creating anything.
+	Plan(cfg *Config) ([]PlanBenchmark, error)
+}
+
+// Phases of a suite run, as reported in events.
+const (
+	PhasePrepare = "prepare"
+	PhaseVerify  = "verify"
+	PhaseRun     = "run"
+	PhaseCleanup = "cleanup"
+)
+
+// EventKind is the kind of a progress event.
+type EventKind string
+
+const (
+	// EventPhase is sent when a phase of the suite starts.
+	EventPhase EventKind = "phase"
+	// EventVariantStart is sent when a variant of a benchmark starts being
+	// timed.
+	EventVariantStart EventKind = "variant_started"
+	// EventSample is sent for every measured run of a variant the runner
+	// times itself.
+	EventSample EventKind = "sample"
+	// EventVariantDone is sent with the outcome of a variant, including
+	// variants that failed before they could be timed.
+	EventVariantDone EventKind = "variant_finished"
+	// EventResult is sent when a benchmark result has been recorded.
+	EventResult EventKind = "result"
+	// EventSummary is sent once the suite has run and cleaned up.
+	EventSummary EventKind = "summary"
+	// EventReport is sent once every suite of a run of several suites is
+	// done.
+	EventReport EventKind = "report"
+)
+
+// Event reports the progress of a suite run. Its JSON form is what
+// benchrunner prints with --output ndjson.
+type Event struct {
+	Kind  EventKind `json:"event"`
+	Time  time.Time `json:"time"`
+	Suite string    `json:"suite"`
+	Phase string    `json:"phase,omitempty"` // EventPhase: the phase that starts
+
+	// Variant events: the benchmark ("compute/n-body"), its parameters and
+	// the variant
+	Benchmark string            `json:"benchmark,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	Variant   string            `json:"variant,omitempty"`
+
+	Iteration int              `json:"iteration,omitempty"` // EventSample: the measured run, from 1
+	Sample    []metrics.Metric `json:"sample,omitempty"`    // EventSample: its timings (ms) and metrics
+	Outcome   *results.Variant `json:"outcome,omitempty"`   // EventVariantDone
+	Result    *results.Suite   `json:"result,omitempty"`    // EventResult: the recorded result
+	Results   []*results.Suite `json:"results,omitempty"`   // EventSummary: every recorded result
+	Error     string           `json:"error,omitempty"`     // EventSummary: why the run failed
+	Report    *results.Report  `json:"report,omitempty"`    // EventReport: the consolidated report
+}
+
+// Options are the settings shared by the suites. Each suite only takes the
+// flags of the options it uses (see Flags).
+type Options struct {
+	Warmup       int
+	Runs         int
+	Mode         string // compile, full-cold, full-hot or exec
+	Tool         string // auto, poop, hyperfine or builtin
+	MetricRuns   int    // runs collecting reported metrics after poop or hyperfine
+	KeepDir      string // copy built artifacts here before cleanup
+	Jobs         int    // concurrent builds
+	NoBuildCache bool
+
+	Select  []string // selectors of which variants must match one
+	Exclude []string // selectors of which variants must match none
+
+	Order     string // sequential, interleaved or random (see Schedule)
+	OrderSeed int64  // seed of the random order; 0 picks one when the run starts
+
+	CompileTimeout time.Duration
+	PrepareTimeout time.Duration
+	RunTimeout     time.Duration
+}
+
+// DefaultOptions returns the options a suite runs with when no flag is set.
+func DefaultOptions() Options {
+	return Options{
+		Warmup:         3,
+		Runs:           10,
+		Mode:           "exec",
+		Tool:           "auto",
+		MetricRuns:     1,
+		Order:          OrderSequential,
+		Jobs:           runtime.NumCPU(),
+		CompileTimeout: 10 * time.Minute,
+		PrepareTimeout: 2 * time.Minute,
+		RunTimeout:     2 * time.Minute,
+	}
+}
+
+// Config is the configuration of one suite run.
+type Config struct {
+	Options
+	Suite      string      // name of the suite, set by Execute
+	BaseDir    string      // repository root
+	Targets    []string    // variant selectors from the command line; empty selects all
+	ResultsDir string      // where results are saved; empty: not saved
+	Progress   func(Event) // optional: called on every event
+	Log        io.Writer   // progress logs of the suite and its programs; nil: standard output
+
+	// Results holds every result recorded so far, in order.
+	Results []*results.Suite
+}
+
+// Record adds the result of a benchmark run to Results, saves it into the
+// results directory and reports it.
+func (c *Config) Record(s *results.Suite) {
+	c.Results = append(c.Results, s)
+	if c.ResultsDir != "" {
+		if path, err := results.Save(c.ResultsDir, s); err != nil {
+			fmt.Fprintf(c.Log, "WARNING: Failed to save results: %v\n", err)
+		} else {
+			fmt.Fprintf(c.Log, "\nResults saved to: %s\n", path)
+		}
+	}
+	c.Emit(Event{Kind: EventResult, Result: s})
+}
+
+// defaultLog sends the logs to standard output unless Log is set
+func (c *Config) defaultLog() {
+	if c.Log == nil {
+		c.Log = os.Stdout
+	}
+}
+
+// Emit reports e to Progress, if set, stamped with the suite and the time.
+func (c *Config) Emit(e Event) {
+	if c.Progress == nil {
+		return
+	}
+	e.Suite = c.Suite
+	e.Time = time.Now()
+	c.Progress(e)
+}
+
+// Execute runs the named suite with cfg. It fails early if the targets
+// select none of the suite's variants, and stops between phases once ctx
+// is done. The suites themselves stop what they are running and record
+// what they measured so far.
+func Execute(ctx context.Context, name string, s Suite, cfg *Config) (err error) {
+	cfg.Suite = name
+	cfg.defaultLog()
+	if err := cfg.checkOrder(); err != nil {
+		return err
+	}
+	if err := checkTargets(name, s, cfg); err != nil {
+		return err
+	}
+	cfg.seedOrder()
+
+	defer func() {
+		// Cleanup runs to completion even when the run was cancelled
+		cfg.Emit(Event{Kind: EventPhase, Phase: PhaseCleanup})
+		if cleanupErr := s.Cleanup(context.WithoutCancel(ctx), cfg); cleanupErr != nil && err == nil {
+			err = cleanupErr
+		}
+		summary := Event{Kind: EventSummary, Results: cfg.Results}
+		if err != nil {
+			summary.Error = err.Error()
+		}
+		cfg.Emit(summary)
+	}()
+	phases := []struct {
+		name string
+		run  func(context.Context, *Config) error
+	}{
+		{PhasePrepare, s.Prepare},
+		{PhaseVerify, s.Verify},
+		{PhaseRun, s.Run},
+	}
+	for _, phase := range phases {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cfg.Emit(Event{Kind: EventPhase, Phase: phase.name})
+		if err := phase.run(ctx, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
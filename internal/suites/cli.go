@@ -0,0 +1,252 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/benchmarks/internal/inputgen"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "cli",
+		Short: "Run CLI (rectangle YAML parsing) benchmarks",
+		Long: `Compile and benchmark rectangle YAML parsing programs in various languages using poop (or hyperfine as fallback).
+
+` + modeHelp + `
+
+--input-size chooses the YAML input: tiny is the checked-in single
+rectangle; small (100), medium (10k), large (100k, ~19 MB) and huge
+(1M, ~190 MB) or any number of rectangles are generated once, cached
+with a sha256 checksum and reused. Every program's output is checked
+against the go variant's before timing.`,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &cliSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// cliSuite runs the rectangle parsers on one YAML input
+type cliSuite struct {
+	programSets
+	baseDir   string
+	inputSize string
+}
+
+func (s *cliSuite) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.inputSize, "input-size", "tiny", "Input file: tiny, small, medium, large, huge, or a number of rectangles")
+}
+
+func (s *cliSuite) Variants() []suite.Variant {
+	return variantNames(getCLILanguages(s.baseDir))
+}
+
+func (s *cliSuite) sets(input string) programSets {
+	return programSets{{
+		name:     "cli",
+		params:   map[string]string{"input-size": s.inputSize},
+		programs: withArgs(getCLILanguages(s.baseDir), input),
+	}}
+}
+
+func (s *cliSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	input, err := s.resolveInput(cfg)
+	if err != nil {
+		return err
+	}
+	s.programSets = s.sets(input)
+	return s.programSets.prepare(ctx, cfg)
+}
+
+// Plan lists the input file without generating it.
+func (s *cliSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	rectangles, err := s.inputRectangles()
+	if err != nil {
+		return nil, err
+	}
+	input := filepath.Join(s.baseDir, "cli", "test_rectangle.yaml")
+	if rectangles > 0 {
+		dir, err := inputgen.DefaultDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to locate input cache: %w", err)
+		}
+		input = filepath.Join(dir, cliInputName(rectangles))
+	}
+	sets := s.sets(input)
+	if rectangles > 0 {
+		sets[0].inputs = []string{fmt.Sprintf("%s (%d rectangles, generated if not cached)", input, rectangles)}
+	}
+	return sets.plan(cfg)
+}
+
+func (s *cliSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, nil)
+}
+
+func getCLILanguages(baseDir string) []program {
+	cliDir := filepath.Join(baseDir, "cli")
+	return []program{
+		{
+			name:       "cpp",
+			dir:        filepath.Join(cliDir, "cpp"),
+			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build -j$(nproc)",
+			runCmd:     "./build/rectangle",
+			binaryPath: "build/rectangle",
+			cleanCmd:   "rm -rf build",
+		},
+		{
+			name:       "go",
+			dir:        filepath.Join(cliDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o rectangle rectangle.go",
+			runCmd:     "./rectangle",
+			binaryPath: "rectangle",
+			cleanCmd:   "rm -f rectangle",
+			fullHotCmd: "go run rectangle.go",
+			reference:  true,
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(cliDir, "rust"),
+			compileCmd: "cargo build --release",
+			runCmd:     "./target/release/rectangle",
+			binaryPath: "target/release/rectangle",
+			cleanCmd:   "cargo clean",
+		},
+		{
+			name:       "zig",
+			dir:        filepath.Join(cliDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=rectangle rectangle.zig",
+			runCmd:     "./rectangle",
+			binaryPath: "rectangle",
+			cleanCmd:   "rm -f rectangle rectangle.o",
+		},
+		{
+			name:   "nodejs-direct",
+			dir:    filepath.Join(cliDir, "node"),
+			runCmd: "node rectangle.js",
+		},
+		{
+			name:       "nodejs-build",
+			dir:        filepath.Join(cliDir, "node"),
+			compileCmd: "npx esbuild rectangle.js --bundle --minify --platform=node --format=cjs --outfile=rectangle.min.cjs",
+			runCmd:     "node rectangle.min.cjs",
+			binaryPath: "rectangle.min.cjs",
+			cleanCmd:   "rm -f rectangle.min.cjs",
+		},
+		{
+			name:       "nodets-direct",
+			dir:        filepath.Join(cliDir, "node"),
+			compileCmd: "npx tsc",
+			runCmd:     "node dist/rectangle.js",
+			binaryPath: "dist/rectangle.js",
+			cleanCmd:   "rm -rf dist",
+		},
+		{
+			name:       "nodets-build",
+			dir:        filepath.Join(cliDir, "node"),
+			compileCmd: "npx tsc --noEmit && npx esbuild rectangle.ts --bundle --minify --platform=node --format=cjs --outfile=rectangle.min.cjs",
+			runCmd:     "node rectangle.min.cjs",
+			binaryPath: "rectangle.min.cjs",
+			cleanCmd:   "rm -f rectangle.min.cjs",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(cliDir, "python"),
+			runCmd: "python3 rectangle.py",
+		},
+		{
+			name:       "java",
+			dir:        filepath.Join(cliDir, "java"),
+			compileCmd: "javac -cp snakeyaml.jar Rectangle.java",
+			runCmd:     "java -cp .:snakeyaml.jar Rectangle",
+			binaryPath: "Rectangle.class",
+			cleanCmd:   "rm -f *.class",
+		},
+	}
+}
+
+// cliInputSeed seeds every generated cli input, so a size always names the
+// same file
+const cliInputSeed = 42
+
+// cliInputSizes are the named --input-size presets, in rectangles. "tiny"
+// is the checked-in single rectangle.
+var cliInputSizes = []struct {
+	name       string
+	rectangles int
+}{
+	{"small", 100},
+	{"medium", 10000},
+	{"large", 100000}, // ~19 MB
+	{"huge", 1000000}, // ~190 MB
+}
+
+// inputRectangles returns the number of rectangles --input-size asks for,
+// or 0 for the checked-in "tiny" file
+func (s *cliSuite) inputRectangles() (int, error) {
+	size := s.inputSize
+	if size == "" || size == "tiny" {
+		return 0, nil
+	}
+
+	rectangles := 0
+	for _, preset := range cliInputSizes {
+		if preset.name == size {
+			rectangles = preset.rectangles
+		}
+	}
+	if rectangles == 0 {
+		sizes, err := parseSizes(size)
+		if err != nil || len(sizes) != 1 {
+			var names []string
+			for _, preset := range cliInputSizes {
+				names = append(names, preset.name)
+			}
+			return 0, fmt.Errorf("invalid input size: %q (valid: tiny, %s, or a number of rectangles)", size, strings.Join(names, ", "))
+		}
+		rectangles = sizes[0]
+	}
+	return rectangles, nil
+}
+
+// cliInputName is the input cache entry of a generated input
+func cliInputName(rectangles int) string {
+	return fmt.Sprintf("cli-rectangles-%d-seed%d-v%d.yaml", rectangles, cliInputSeed, inputgen.Version)
+}
+
+// resolveInput returns the path of the YAML file for --input-size:
+// the checked-in file for "tiny", otherwise a generated file from the
+// input cache, generating it on first use.
+func (s *cliSuite) resolveInput(cfg *suite.Config) (string, error) {
+	rectangles, err := s.inputRectangles()
+	if err != nil {
+		return "", err
+	}
+	if rectangles == 0 {
+		return filepath.Join(s.baseDir, "cli", "test_rectangle.yaml"), nil
+	}
+
+	dir, err := inputgen.DefaultDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate input cache: %w", err)
+	}
+	entry, err := inputgen.New(dir).Get(cliInputName(rectangles), func(w io.Writer) error {
+		return inputgen.GenerateYAML(w, rectangles, cliInputSeed)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	origin := "generated"
+	if entry.Cached {
+		origin = "cached"
+	}
+	fmt.Fprintf(cfg.Log, "Input: %s (%d rectangles, %d bytes, sha256 %s, %s)\n", entry.Path, rectangles, entry.Size, entry.SHA256[:12], origin)
+	return entry.Path, nil
+}
@@ -0,0 +1,317 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "compute",
+		Short: "Run compute benchmarks",
+		Long: `Compile and benchmark compute kernels (bubblesort and Benchmarks Game style kernels) in various languages using poop (or hyperfine as fallback).
+
+` + modeHelp + `
+
+Kernels: bubblesort, binary-trees, n-body, spectral-norm, fannkuch-redux,
+k-nucleotide (select with --kernels). The output of every variant is checked
+against the Go reference implementation before timing.
+
+Each program takes an input size whose meaning depends on the kernel; --sizes
+sweeps several sizes and reports results per size: --kernels n-body --sizes
+1e5,1e6 for one kernel, or --sizes n-body=1e5,1e6,bubblesort=1e3,1e4 per
+kernel.`,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &computeSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// computeSuite runs one program set per selected kernel and input size
+type computeSuite struct {
+	programSets
+	baseDir string
+	kernels string
+	sizes   string
+	seed    int
+}
+
+func (s *computeSuite) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.kernels, "kernels", "all", "Comma-separated compute kernels to run (bubblesort, binary-trees, n-body, spectral-norm, fannkuch-redux, k-nucleotide)")
+	fs.StringVar(&s.sizes, "sizes", "", "Input sizes to sweep, for one kernel (1e3,1e4) or per kernel (n-body=1e5,1e6,bubblesort=1e3; default: per kernel)")
+	fs.IntVar(&s.seed, "seed", 42, "Seed for the generated input")
+}
+
+func (s *computeSuite) Variants() []suite.Variant {
+	var tables [][]program
+	for _, kernel := range getComputeKernels() {
+		tables = append(tables, kernel.languages(s.baseDir))
+	}
+	return variantNames(tables...)
+}
+
+// sets returns one program set per selected kernel and size
+func (s *computeSuite) sets(cfg *suite.Config) (programSets, error) {
+	kernels, err := selectKernels(getComputeKernels(), s.kernels)
+	if err != nil {
+		return nil, err
+	}
+	var sizes map[string][]int
+	if s.sizes != "" {
+		var names []string
+		for _, kernel := range kernels {
+			names = append(names, kernel.name)
+		}
+		if sizes, err = parseSizeSweep(s.sizes, "kernel", names); err != nil {
+			return nil, err
+		}
+	}
+
+	var sets programSets
+	for i, kernel := range kernels {
+		languages := kernel.languages(s.baseDir)
+		if !anyMatches(cfg, languages) {
+			fmt.Fprintf(cfg.Log, "Skipping %s (no matching variants)\n", kernel.name)
+			continue
+		}
+
+		kernelSizes := sizes[kernel.name]
+		if kernelSizes == nil {
+			kernelSizes = []int{kernel.defaultSize}
+		}
+		for j, size := range kernelSizes {
+			title := fmt.Sprintf("[%d/%d] %s - %s", i+1, len(kernels), kernel.name, kernel.description)
+			if len(kernelSizes) > 1 {
+				title += fmt.Sprintf(", size %d [%d/%d]", size, j+1, len(kernelSizes))
+			}
+			sets = append(sets, &programSet{
+				name:     "compute/" + kernel.name,
+				title:    title,
+				group:    kernel.name,
+				params:   map[string]string{"size": strconv.Itoa(size), "seed": strconv.Itoa(s.seed)},
+				programs: withInputArgs(languages, size, s.seed),
+			})
+		}
+	}
+	return sets, nil
+}
+
+func (s *computeSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	sets, err := s.sets(cfg)
+	if err != nil {
+		return err
+	}
+	s.programSets = sets
+	return s.programSets.prepare(ctx, cfg)
+}
+
+func (s *computeSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	sets, err := s.sets(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return sets.plan(cfg)
+}
+
+func (s *computeSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, func(w io.Writer, suites []*results.Suite) {
+		if len(suites) > 1 {
+			printScalingSummary(w, suites)
+		}
+	})
+}
+
+// computeKernel is one compute workload. Its programs take the input size
+// (and seed) as arguments and print a deterministic result, which is
+// checked against the Go reference implementation.
+type computeKernel struct {
+	name        string
+	description string
+	defaultSize int
+	languages   func(baseDir string) []program
+}
+
+func getComputeKernels() []computeKernel {
+	return []computeKernel{
+		{
+			name:        "bubblesort",
+			description: "sorting a generated array (size = elements)",
+			defaultSize: 10000,
+			languages:   getBubblesortLanguages,
+		},
+		{
+			name:        "binary-trees",
+			description: "allocation and recursion (size = max tree depth)",
+			defaultSize: 16,
+			languages:   kernelLanguages("binarytrees"),
+		},
+		{
+			name:        "n-body",
+			description: "floating point simulation (size = steps)",
+			defaultSize: 1000000,
+			languages:   kernelLanguages("nbody"),
+		},
+		{
+			name:        "spectral-norm",
+			description: "floating point matrix-vector products (size = matrix order)",
+			defaultSize: 1000,
+			languages:   kernelLanguages("spectralnorm"),
+		},
+		{
+			name:        "fannkuch-redux",
+			description: "permutations and array access (size = elements)",
+			defaultSize: 10,
+			languages:   kernelLanguages("fannkuch"),
+		},
+		{
+			name:        "k-nucleotide",
+			description: "hashing k-mers of a generated DNA sequence (size = length)",
+			defaultSize: 1000000,
+			languages:   kernelLanguages("knucleotide"),
+		},
+	}
+}
+
+// kernelLanguages returns the variants of a Benchmarks Game style kernel,
+// whose program is named after the kernel in every language directory
+func kernelLanguages(prog string) func(baseDir string) []program {
+	return func(baseDir string) []program {
+		computeDir := filepath.Join(baseDir, "compute")
+		return []program{
+			{
+				name:       "go",
+				dir:        filepath.Join(computeDir, "go"),
+				compileCmd: fmt.Sprintf("go build -ldflags=\"-s -w\" -o bin/%s ./%s", prog, prog),
+				runCmd:     "./bin/" + prog,
+				binaryPath: "bin/" + prog,
+				cleanCmd:   "rm -rf bin",
+				fullHotCmd: "go run ./" + prog,
+				reference:  true,
+			},
+			{
+				name:       "rust",
+				dir:        filepath.Join(computeDir, "rust"),
+				compileCmd: fmt.Sprintf("rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o %s %s.rs", prog, prog),
+				runCmd:     "./" + prog,
+				binaryPath: prog,
+				cleanCmd:   "rm -f " + prog,
+			},
+			{
+				name:   "nodejs-direct",
+				dir:    filepath.Join(computeDir, "node"),
+				runCmd: fmt.Sprintf("node %s.js", prog),
+			},
+			{
+				name:     "python",
+				dir:      filepath.Join(computeDir, "python"),
+				runCmd:   fmt.Sprintf("python3 %s.py", prog),
+				timeouts: stepTimeouts{run: 10 * time.Minute},
+			},
+		}
+	}
+}
+
+func getBubblesortLanguages(baseDir string) []program {
+	computeDir := filepath.Join(baseDir, "compute")
+	return []program{
+		{
+			name:       "go",
+			dir:        filepath.Join(computeDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o bin/bubblesort ./bubblesort",
+			runCmd:     "./bin/bubblesort",
+			binaryPath: "bin/bubblesort",
+			cleanCmd:   "rm -rf bin",
+			fullHotCmd: "go run ./bubblesort",
+			reference:  true,
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(computeDir, "rust"),
+			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o bubblesort bubblesort.rs",
+			runCmd:     "./bubblesort",
+			binaryPath: "bubblesort",
+			cleanCmd:   "rm -f bubblesort",
+		},
+		{
+			name:       "zig",
+			dir:        filepath.Join(computeDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=bubblesort bubblesort.zig",
+			runCmd:     "./bubblesort",
+			binaryPath: "bubblesort",
+			cleanCmd:   "rm -f bubblesort bubblesort.o",
+		},
+		{
+			name:   "nodejs-direct",
+			dir:    filepath.Join(computeDir, "node"),
+			runCmd: "node bubblesort.js",
+		},
+		{
+			name:       "nodejs-build",
+			dir:        filepath.Join(computeDir, "node"),
+			compileCmd: "npx esbuild bubblesort.js --bundle --minify --platform=node --format=esm --outfile=bubblesort.min.js",
+			runCmd:     "node bubblesort.min.js",
+			binaryPath: "bubblesort.min.js",
+			cleanCmd:   "rm -f bubblesort.min.js",
+		},
+		{
+			name:       "nodets-direct",
+			dir:        filepath.Join(computeDir, "node"),
+			compileCmd: "npx tsc",
+			runCmd:     "node dist/bubblesort.js",
+			binaryPath: "dist/bubblesort.js",
+			cleanCmd:   "rm -rf dist",
+		},
+		{
+			name:       "nodets-build",
+			dir:        filepath.Join(computeDir, "node"),
+			compileCmd: "npx tsc --noEmit && npx esbuild bubblesort.ts --bundle --minify --platform=node --format=esm --outfile=bubblesort.min.js",
+			runCmd:     "node bubblesort.min.js",
+			binaryPath: "bubblesort.min.js",
+			cleanCmd:   "rm -f bubblesort.min.js",
+		},
+		{
+			name:     "python",
+			dir:      filepath.Join(computeDir, "python"),
+			runCmd:   "python3 bubblesort.py",
+			timeouts: stepTimeouts{run: 10 * time.Minute},
+		},
+	}
+}
+
+// selectKernels returns the kernels named in the comma-separated list, or
+// all of them for "all"
+func selectKernels(kernels []computeKernel, list string) ([]computeKernel, error) {
+	if list == "" || list == "all" {
+		return kernels, nil
+	}
+	var selected []computeKernel
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, kernel := range kernels {
+			if kernel.name == name {
+				selected = append(selected, kernel)
+				found = true
+				break
+			}
+		}
+		if !found {
+			var names []string
+			for _, kernel := range kernels {
+				names = append(names, kernel.name)
+			}
+			return nil, fmt.Errorf("unknown kernel: %s (valid: %s)", name, strings.Join(names, ", "))
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,266 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "concurrency",
+		Short: "Run concurrency (tasks, channels, map-reduce, locks) benchmarks",
+		Long: `Compile and benchmark concurrency workloads in various languages using poop (or hyperfine as fallback).
+
+Runs one sub-benchmark per workload (select with --workloads):
+  spawn      - spawn 10k lightweight tasks and collect their results
+  pingpong   - 100k channel/queue round trips, split across task pairs
+  mapreduce  - parallel sum over 20M hashed items, one chunk per worker
+  mutex      - 1M increments of one shared counter under a lock
+
+Each workload runs at every parallelism level in --levels: the number of
+OS threads the runtime may use (GOMAXPROCS, tokio worker threads, Java
+carrier threads) or the number of threads, workers or processes started.
+The total work is the same at every level. Every program reports its
+workload time, which is summarised per level with the speedup over the
+first level, and prints a result that is checked against Go's.`,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &concurrencySuite{baseDir: baseDir}
+		},
+	})
+}
+
+// concurrencySuite runs one program set per workload and parallelism level
+type concurrencySuite struct {
+	programSets
+	baseDir   string
+	workloads string
+	levels    string
+}
+
+func (s *concurrencySuite) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.workloads, "workloads", "all", "Comma-separated workloads to run (spawn, pingpong, mapreduce, mutex)")
+	fs.StringVar(&s.levels, "levels", "1,2,4,8", "Comma-separated parallelism levels")
+}
+
+type concurrencyWorkload struct {
+	name        string
+	description string
+}
+
+func getConcurrencyWorkloads() []concurrencyWorkload {
+	return []concurrencyWorkload{
+		{"spawn", "spawning 10k lightweight tasks and collecting their results"},
+		{"pingpong", "100k channel/queue round trips, split across task pairs"},
+		{"mapreduce", "parallel sum over 20M hashed items, one chunk per worker"},
+		{"mutex", "1M increments of one shared counter under a lock"},
+	}
+}
+
+// getConcurrencyLanguages returns the variants; every program takes the
+// workload and the parallelism level as its arguments
+func getConcurrencyLanguages(baseDir string) []program {
+	concurrencyDir := filepath.Join(baseDir, "concurrency")
+	return []program{
+		{
+			name:       "go",
+			dir:        filepath.Join(concurrencyDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			fullHotCmd: "go run main.go",
+			reference:  true,
+		},
+		{
+			name:       "rust-threads",
+			dir:        filepath.Join(concurrencyDir, "rust-threads"),
+			compileCmd: "rustc -C opt-level=3 -C lto=fat -C strip=symbols -o main main.rs",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+		},
+		{
+			name:       "rust-tokio",
+			dir:        filepath.Join(concurrencyDir, "rust-tokio"),
+			compileCmd: "cargo build --release",
+			runCmd:     "./target/release/concurrency",
+			binaryPath: "target/release/concurrency",
+			cleanCmd:   "cargo clean",
+		},
+		{
+			name:   "nodejs",
+			dir:    filepath.Join(concurrencyDir, "node"),
+			runCmd: "node main.js",
+		},
+		{
+			name:       "java",
+			dir:        filepath.Join(concurrencyDir, "java"),
+			compileCmd: "javac Main.java",
+			runCmd:     "java Main",
+			binaryPath: "Main.class",
+			outputs:    []string{"Main$Workload.class"},
+			cleanCmd:   "rm -f *.class",
+		},
+		{
+			name:     "python",
+			dir:      filepath.Join(concurrencyDir, "python"),
+			runCmd:   "python3 main.py",
+			timeouts: stepTimeouts{run: 10 * time.Minute},
+		},
+	}
+}
+
+func (s *concurrencySuite) Variants() []suite.Variant {
+	return variantNames(getConcurrencyLanguages(s.baseDir))
+}
+
+// sets returns one program set per workload and parallelism level
+func (s *concurrencySuite) sets() (programSets, error) {
+	workloads, err := selectConcurrencyWorkloads(s.workloads)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := parseSizes(s.levels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --levels: %w", err)
+	}
+
+	var sets programSets
+	languages := getConcurrencyLanguages(s.baseDir)
+	for i, workload := range workloads {
+		for j, level := range levels {
+			sets = append(sets, &programSet{
+				name: "concurrency/" + workload.name,
+				title: fmt.Sprintf("[%d/%d] %s - %s, parallelism %d [%d/%d]",
+					i+1, len(workloads), workload.name, workload.description, level, j+1, len(levels)),
+				group:    workload.name,
+				params:   map[string]string{"parallelism": strconv.Itoa(level)},
+				programs: withArgs(languages, workload.name, strconv.Itoa(level)),
+			})
+		}
+	}
+	return sets, nil
+}
+
+// Prepare prepares one program set per workload and parallelism level.
+func (s *concurrencySuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	sets, err := s.sets()
+	if err != nil {
+		return err
+	}
+	s.programSets = sets
+	return s.programSets.prepare(ctx, cfg)
+}
+
+func (s *concurrencySuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	sets, err := s.sets()
+	if err != nil {
+		return nil, err
+	}
+	return sets.plan(cfg)
+}
+
+func (s *concurrencySuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, printParallelismSummary)
+}
+
+// selectConcurrencyWorkloads returns the workloads named in the
+// comma-separated list, or all of them for "all"
+func selectConcurrencyWorkloads(list string) ([]concurrencyWorkload, error) {
+	workloads := getConcurrencyWorkloads()
+	if list == "" || list == "all" {
+		return workloads, nil
+	}
+	var selected []concurrencyWorkload
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, workload := range workloads {
+			if workload.name == name {
+				selected = append(selected, workload)
+				found = true
+				break
+			}
+		}
+		if !found {
+			var names []string
+			for _, workload := range workloads {
+				names = append(names, workload.name)
+			}
+			return nil, fmt.Errorf("unknown workload: %s (valid: %s)", name, strings.Join(names, ", "))
+		}
+	}
+	return selected, nil
+}
+
+// printParallelismSummary prints the workload time every variant reported
+// (its workload_time metric, without process startup) at each parallelism
+// level, and its speedup over the first level
+func printParallelismSummary(w io.Writer, suites []*results.Suite) {
+	if len(suites) == 0 {
+		return
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, s := range suites {
+		for _, v := range s.Variants {
+			if !seen[v.Name] {
+				seen[v.Name] = true
+				names = append(names, v.Name)
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintf(w, "PARALLELISM: %s (mean workload time, ms; speedup over P=%s)\n", suites[0].Suite, suites[0].Params["parallelism"])
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-14s", "Language")
+	for _, s := range suites {
+		fmt.Fprintf(w, " %16s", "P="+s.Params["parallelism"])
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%-14s", name)
+		baseline := 0.0
+		for i, s := range suites {
+			cell := "-"
+			for _, v := range s.Variants {
+				if v.Name != name {
+					continue
+				}
+				if v.Status != results.StatusOK {
+					cell = v.Status
+					continue
+				}
+				for _, m := range v.Metrics {
+					if m.Name != "workload_time" {
+						continue
+					}
+					if i == 0 {
+						baseline = m.Mean
+					}
+					cell = fmt.Sprintf("%.2f", m.Mean)
+					if baseline > 0 && m.Mean > 0 {
+						cell += fmt.Sprintf(" (%.2fx)", baseline/m.Mean)
+					}
+				}
+			}
+			fmt.Fprintf(w, " %16s", cell)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
@@ -0,0 +1,271 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/benchmarks/internal/suite"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "ffi",
+		Short: "Run FFI benchmarks (call overhead, compute and call shapes)",
+		Long: `Compile and benchmark FFI programs in various languages using poop (or hyperfine as fallback).
+
+Runs six sub-benchmarks sequentially:
+  - fast_sum: measures FFI call overhead (1M calls of a simple sum function)
+  - slow_compute: measures compute-heavy FFI (100 calls with 1M iterations each)
+  - strings, buffers, struct_ptr, callback: measure passing strings, byte
+    buffers and structs, and calling back into the host language (1M calls each)
+
+A call shape × language matrix of the per-call times the programs report is
+printed at the end.
+
+` + modeHelp,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &ffiSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// ffiSuite runs one program set per FFI sub-benchmark
+type ffiSuite struct {
+	programSets
+	baseDir string
+}
+
+func (s *ffiSuite) Variants() []suite.Variant {
+	var tables [][]program
+	for _, b := range getFFIBenchmarks() {
+		tables = append(tables, b.languages(s.baseDir))
+	}
+	return variantNames(tables...)
+}
+
+// sets returns one program set per sub-benchmark with matching variants
+func (s *ffiSuite) sets(cfg *suite.Config) programSets {
+	benchmarks := getFFIBenchmarks()
+	var sets programSets
+	for i, b := range benchmarks {
+		languages := b.languages(s.baseDir)
+		if !anyMatches(cfg, languages) {
+			fmt.Fprintf(cfg.Log, "Skipping %s (no matching variants)\n", b.name)
+			continue
+		}
+		sets = append(sets, &programSet{
+			name:     "ffi/" + b.name,
+			title:    fmt.Sprintf("[%d/%d] %s - %s", i+1, len(benchmarks), b.name, b.description),
+			programs: languages,
+		})
+	}
+	return sets
+}
+
+func (s *ffiSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	fmt.Fprintf(cfg.Log, "Running FFI benchmarks (%d sub-benchmarks)\n", len(getFFIBenchmarks()))
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+	s.programSets = s.sets(cfg)
+	return s.programSets.prepare(ctx, cfg)
+}
+
+func (s *ffiSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	return s.sets(cfg).plan(cfg)
+}
+
+func (s *ffiSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, printCallShapeMatrix)
+}
+
+// ffiShared are the files of a benchmark directory its native variants
+// compile against
+var ffiShared = []string{"hotpath.cpp", "hotpath.h"}
+
+func getFFIFastSumLanguages(baseDir string) []program {
+	ffiDir := filepath.Join(baseDir, "ffi", "fast_sum")
+	return []program{
+		{
+			name:       "cpp",
+			dir:        filepath.Join(ffiDir, "cpp"),
+			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o main main.cpp ../hotpath.cpp",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-cgo",
+			dir:        filepath.Join(ffiDir, "go"),
+			compileCmd: "g++ -O3 -fPIC -c -o ../hotpath.o ../hotpath.cpp && ar rcs ../libhotpath.a ../hotpath.o && go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main ../hotpath.o ../libhotpath.a",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-native",
+			dir:        filepath.Join(ffiDir, "go-native"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			fullHotCmd: "go run main.go",
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(ffiDir, "rust"),
+			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o main main.rs",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+		},
+		{
+			name:       "zig",
+			dir:        filepath.Join(ffiDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=main main.zig",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main main.o",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(ffiDir, "python"),
+			runCmd: "python3 main.py",
+		},
+	}
+}
+
+func getFFISlowComputeLanguages(baseDir string) []program {
+	ffiDir := filepath.Join(baseDir, "ffi", "slow_compute")
+	return []program{
+		{
+			name:       "cpp",
+			dir:        filepath.Join(ffiDir, "cpp"),
+			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o main main.cpp ../hotpath.cpp",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-cgo",
+			dir:        filepath.Join(ffiDir, "go"),
+			compileCmd: "g++ -O3 -fPIC -c -o ../hotpath.o ../hotpath.cpp && ar rcs ../libhotpath.a ../hotpath.o && go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main ../hotpath.o ../libhotpath.a",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-native",
+			dir:        filepath.Join(ffiDir, "go-native"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			fullHotCmd: "go run main.go",
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(ffiDir, "rust"),
+			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o main main.rs",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+		},
+		{
+			name:       "zig",
+			dir:        filepath.Join(ffiDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=main main.zig",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main main.o",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(ffiDir, "python"),
+			runCmd: "python3 main.py",
+		},
+	}
+}
+
+// getFFICallShapeLanguages returns the variants of a call-shape sub-benchmark
+// (strings, buffers, struct_ptr, callback). Rust and Python call hotpath.cpp
+// through real FFI here (extern "C" and ctypes), so they build the library
+// inside their own directory.
+func getFFICallShapeLanguages(baseDir, shape string) []program {
+	ffiDir := filepath.Join(baseDir, "ffi", shape)
+	return []program{
+		{
+			name:       "cpp",
+			dir:        filepath.Join(ffiDir, "cpp"),
+			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o main main.cpp ../hotpath.cpp",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-cgo",
+			dir:        filepath.Join(ffiDir, "go"),
+			compileCmd: "g++ -O3 -fPIC -c -o ../hotpath.o ../hotpath.cpp && ar rcs ../libhotpath.a ../hotpath.o && go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main ../hotpath.o ../libhotpath.a",
+			shared:     ffiShared,
+		},
+		{
+			name:       "go-native",
+			dir:        filepath.Join(ffiDir, "go-native"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main",
+			fullHotCmd: "go run main.go",
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(ffiDir, "rust"),
+			compileCmd: "g++ -O3 -fPIC -c -o hotpath.o ../hotpath.cpp && ar rcs libhotpath.a hotpath.o && rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -L . -l static=hotpath -o main main.rs",
+			runCmd:     "./main",
+			binaryPath: "main",
+			cleanCmd:   "rm -f main hotpath.o libhotpath.a",
+			shared:     ffiShared,
+		},
+		{
+			name:       "python",
+			dir:        filepath.Join(ffiDir, "python"),
+			compileCmd: "g++ -O3 -fPIC -shared -o libhotpath.so ../hotpath.cpp",
+			runCmd:     "python3 main.py",
+			binaryPath: "libhotpath.so",
+			cleanCmd:   "rm -f libhotpath.so",
+			shared:     ffiShared,
+		},
+	}
+}
+
+// ffiBenchmark is one FFI sub-benchmark
+type ffiBenchmark struct {
+	name        string
+	description string
+	languages   func(baseDir string) []program
+}
+
+func getFFIBenchmarks() []ffiBenchmark {
+	callShape := func(shape string) func(string) []program {
+		return func(baseDir string) []program {
+			return getFFICallShapeLanguages(baseDir, shape)
+		}
+	}
+	return []ffiBenchmark{
+		{"fast_sum", "FFI call overhead benchmark", getFFIFastSumLanguages},
+		{"slow_compute", "compute-heavy FFI benchmark", getFFISlowComputeLanguages},
+		{"strings", "passing strings", callShape("strings")},
+		{"buffers", "passing byte buffers in and out", callShape("buffers")},
+		{"struct_ptr", "passing a struct by pointer", callShape("struct_ptr")},
+		{"callback", "callbacks from C into the host language", callShape("callback")},
+	}
+}
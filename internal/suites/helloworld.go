@@ -0,0 +1,177 @@
+package suites
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/benchmarks/internal/suite"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "helloworld",
+		Short: "Run helloworld benchmarks",
+		Long: `Compile and benchmark helloworld programs in various languages using poop (or hyperfine as fallback).
+
+` + modeHelp,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &helloworldSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// helloworldSuite builds and runs a program printing a greeting in every
+// language and build flavour
+type helloworldSuite struct {
+	programSets
+	baseDir string
+}
+
+func (s *helloworldSuite) Variants() []suite.Variant {
+	return variantNames(getHelloworldLanguages(s.baseDir))
+}
+
+func (s *helloworldSuite) sets() programSets {
+	return programSets{{name: "helloworld", programs: getHelloworldLanguages(s.baseDir)}}
+}
+
+func (s *helloworldSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	s.programSets = s.sets()
+	return s.programSets.prepare(ctx, cfg)
+}
+
+func (s *helloworldSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	return s.sets().plan(cfg)
+}
+
+func (s *helloworldSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, nil)
+}
+
+func getHelloworldLanguages(baseDir string) []program {
+	hwDir := filepath.Join(baseDir, "helloworld")
+	return []program{
+		// C variants
+		{
+			name:       "c-cmake",
+			dir:        filepath.Join(hwDir, "c"),
+			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build",
+			runCmd:     "./build/hello",
+			binaryPath: "build/hello",
+			cleanCmd:   "rm -rf build",
+		},
+		{
+			name:       "c-direct",
+			dir:        filepath.Join(hwDir, "c"),
+			compileCmd: "gcc -O3 -flto -march=native -DNDEBUG -s -o hello main.c",
+			runCmd:     "./hello",
+			binaryPath: "hello",
+			cleanCmd:   "rm -f hello",
+		},
+		// C++ variants
+		{
+			name:       "cpp-cmake",
+			dir:        filepath.Join(hwDir, "cpp"),
+			compileCmd: "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build",
+			runCmd:     "./build/hello",
+			binaryPath: "build/hello",
+			cleanCmd:   "rm -rf build",
+		},
+		{
+			name:       "cpp-direct",
+			dir:        filepath.Join(hwDir, "cpp"),
+			compileCmd: "g++ -O3 -flto -march=native -DNDEBUG -s -o hello main.cpp",
+			runCmd:     "./hello",
+			binaryPath: "hello",
+			cleanCmd:   "rm -f hello",
+		},
+		// Go (already direct)
+		{
+			name:       "go",
+			dir:        filepath.Join(hwDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o hello main.go",
+			runCmd:     "./hello",
+			binaryPath: "hello",
+			cleanCmd:   "rm -f hello",
+			fullHotCmd: "go run main.go",
+		},
+		// Rust variants
+		{
+			name:       "rust-cargo",
+			dir:        filepath.Join(hwDir, "rust"),
+			compileCmd: "cargo build --release",
+			runCmd:     "./target/release/hello",
+			binaryPath: "target/release/hello",
+			cleanCmd:   "cargo clean",
+		},
+		{
+			name:       "rust-direct",
+			dir:        filepath.Join(hwDir, "rust"),
+			compileCmd: "rustc -C opt-level=3 -C lto=fat -C target-cpu=native -C strip=symbols -o hello src/main.rs",
+			runCmd:     "./hello",
+			binaryPath: "hello",
+			cleanCmd:   "rm -f hello",
+		},
+		// Zig variants
+		{
+			name:       "zig-build",
+			dir:        filepath.Join(hwDir, "zig"),
+			compileCmd: "zig build -Doptimize=ReleaseFast",
+			runCmd:     "./zig-out/bin/hello",
+			binaryPath: "zig-out/bin/hello",
+			cleanCmd:   "rm -rf zig-out .zig-cache",
+		},
+		{
+			name:       "zig-direct",
+			dir:        filepath.Join(hwDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=hello main.zig",
+			runCmd:     "./hello",
+			binaryPath: "hello",
+			cleanCmd:   "rm -f hello hello.o",
+		},
+		// Interpreted languages
+		{
+			name:   "nodejs-direct",
+			dir:    filepath.Join(hwDir, "node"),
+			runCmd: "node main.js",
+		},
+		{
+			name:       "nodejs-build",
+			dir:        filepath.Join(hwDir, "node"),
+			compileCmd: "npx esbuild main.js --bundle --minify --platform=node --format=esm --outfile=main.min.js",
+			runCmd:     "node main.min.js",
+			binaryPath: "main.min.js",
+			cleanCmd:   "rm -f main.min.js",
+		},
+		{
+			name:       "nodets-direct",
+			dir:        filepath.Join(hwDir, "node"),
+			compileCmd: "npx tsc",
+			runCmd:     "node dist/main.js",
+			binaryPath: "dist/main.js",
+			cleanCmd:   "rm -rf dist",
+		},
+		{
+			name:       "nodets-build",
+			dir:        filepath.Join(hwDir, "node"),
+			compileCmd: "npx tsc --noEmit && npx esbuild main.ts --bundle --minify --platform=node --format=esm --outfile=main.min.js",
+			runCmd:     "node main.min.js",
+			binaryPath: "main.min.js",
+			cleanCmd:   "rm -f main.min.js",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(hwDir, "python"),
+			runCmd: "python3 main.py",
+		},
+		{
+			name:       "java",
+			dir:        filepath.Join(hwDir, "java"),
+			compileCmd: "javac Main.java",
+			runCmd:     "java Main",
+			binaryPath: "Main.class",
+			cleanCmd:   "rm -f *.class",
+		},
+	}
+}
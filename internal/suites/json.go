@@ -0,0 +1,253 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/benchmarks/internal/inputgen"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "json",
+		Short: "Run JSON parse and serialize benchmarks",
+		Long: `Compile and benchmark JSON parsing and re-serialization in various languages using poop (or hyperfine as fallback).
+
+Runs one sub-benchmark per generated document shape: small-object, large-array
+and nested (select with --shapes). Every program parses the document and
+serializes it again several times, reports both times as metrics and prints a
+checksum of the document, which is checked against the Go reference before
+timing.
+
+Each shape takes a size (fields, records or nesting depth); --sizes sweeps
+several sizes and reports results per size: --shapes nested --sizes 10,100 for
+one shape, or --sizes nested=10,100,large-array=1e4 per shape.`,
+		Flags: suite.ProgramFlags,
+		New: func(baseDir string) suite.Suite {
+			return &jsonSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// jsonSuite runs one program set per document shape and size
+type jsonSuite struct {
+	programSets
+	baseDir  string
+	shapes   string
+	sizes    string
+	seed     int
+	inputDir string
+}
+
+func (s *jsonSuite) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.shapes, "shapes", "all", "Comma-separated document shapes to run (small-object, large-array, nested)")
+	fs.StringVar(&s.sizes, "sizes", "", "Document sizes to sweep, for one shape (1e3,1e4) or per shape (nested=10,100,large-array=1e4; default: per shape)")
+	fs.IntVar(&s.seed, "seed", 42, "Seed for the generated documents")
+}
+
+// jsonIterations is how many parse and serialize passes each program makes
+// over a document of the shape per run, so that short documents are
+// measured over more than a few microseconds.
+var jsonIterations = map[string]int{
+	"small-object": 20000,
+	"large-array":  5,
+	"nested":       2000,
+}
+
+func getJSONLanguages(baseDir string) []program {
+	jsonDir := filepath.Join(baseDir, "json")
+	return []program{
+		{
+			name:       "go",
+			dir:        filepath.Join(jsonDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o jsonbench jsonbench.go",
+			runCmd:     "./jsonbench",
+			binaryPath: "jsonbench",
+			cleanCmd:   "rm -f jsonbench",
+			fullHotCmd: "go run jsonbench.go",
+			reference:  true,
+		},
+		{
+			name:       "go-stream",
+			dir:        filepath.Join(jsonDir, "go-stream"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o jsonbench jsonbench.go",
+			runCmd:     "./jsonbench",
+			binaryPath: "jsonbench",
+			cleanCmd:   "rm -f jsonbench",
+			fullHotCmd: "go run jsonbench.go",
+		},
+		{
+			name:       "rust",
+			dir:        filepath.Join(jsonDir, "rust"),
+			compileCmd: "cargo build --release",
+			runCmd:     "./target/release/jsonbench",
+			binaryPath: "target/release/jsonbench",
+			cleanCmd:   "cargo clean",
+		},
+		{
+			name:   "nodejs-direct",
+			dir:    filepath.Join(jsonDir, "node"),
+			runCmd: "node jsonbench.js",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(jsonDir, "python"),
+			runCmd: "python3 jsonbench.py",
+		},
+	}
+}
+
+func (s *jsonSuite) Variants() []suite.Variant {
+	return variantNames(getJSONLanguages(s.baseDir))
+}
+
+// jsonInput is a document a program set of the suite reads
+type jsonInput struct {
+	shape string
+	size  int
+	path  string
+}
+
+// sets returns one program set per shape and size, reading the documents
+// from inputDir, and the documents to generate there
+func (s *jsonSuite) sets(inputDir string) (programSets, []jsonInput, error) {
+	shapes, err := selectJSONShapes(s.shapes)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sizes map[string][]int
+	if s.sizes != "" {
+		var names []string
+		for _, shape := range shapes {
+			names = append(names, shape.Name)
+		}
+		if sizes, err = parseSizeSweep(s.sizes, "shape", names); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var sets programSets
+	var inputs []jsonInput
+	languages := getJSONLanguages(s.baseDir)
+	for i, shape := range shapes {
+		shapeSizes := sizes[shape.Name]
+		if shapeSizes == nil {
+			shapeSizes = []int{shape.DefaultSize}
+		}
+		for j, size := range shapeSizes {
+			title := fmt.Sprintf("[%d/%d] %s - %s", i+1, len(shapes), shape.Name, shape.Description)
+			if len(shapeSizes) > 1 {
+				title += fmt.Sprintf(", %d %s [%d/%d]", size, shape.SizeUnit, j+1, len(shapeSizes))
+			}
+
+			input := filepath.Join(inputDir, fmt.Sprintf("%s-%d-%d.json", shape.Name, size, s.seed))
+			inputs = append(inputs, jsonInput{shape: shape.Name, size: size, path: input})
+
+			iterations := jsonIterations[shape.Name]
+			sets = append(sets, &programSet{
+				name:  "json/" + shape.Name,
+				title: title,
+				group: shape.Name,
+				params: map[string]string{
+					"size":       strconv.Itoa(size),
+					"seed":       strconv.Itoa(s.seed),
+					"iterations": strconv.Itoa(iterations),
+				},
+				programs: withArgs(languages, input, strconv.Itoa(iterations)),
+				inputs:   []string{input},
+			})
+		}
+	}
+	return sets, inputs, nil
+}
+
+// Prepare writes the generated documents into a temporary directory and
+// prepares one program set per shape and size.
+func (s *jsonSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	dir, err := os.MkdirTemp("", "benchrunner-json-inputs-")
+	if err != nil {
+		return fmt.Errorf("failed to create input directory: %w", err)
+	}
+	s.inputDir = dir
+
+	sets, inputs, err := s.sets(dir)
+	if err != nil {
+		return err
+	}
+	for _, input := range inputs {
+		doc, err := inputgen.GenerateJSON(input.shape, input.size, s.seed)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(input.path, doc, 0644); err != nil {
+			return fmt.Errorf("failed to write input: %w", err)
+		}
+		fmt.Fprintf(cfg.Log, "Input: %s (%d bytes)\n", filepath.Base(input.path), len(doc))
+	}
+	s.programSets = sets
+	return s.programSets.prepare(ctx, cfg)
+}
+
+// Plan lists the documents under the pattern of the directory Prepare
+// would create, without generating them.
+func (s *jsonSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	sets, _, err := s.sets(filepath.Join(os.TempDir(), "benchrunner-json-inputs-*"))
+	if err != nil {
+		return nil, err
+	}
+	return sets.plan(cfg)
+}
+
+func (s *jsonSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	return s.programSets.run(ctx, cfg, func(w io.Writer, suites []*results.Suite) {
+		if len(suites) > 1 {
+			printScalingSummary(w, suites)
+		}
+	})
+}
+
+// Cleanup cleans up the program sets and removes the generated documents.
+func (s *jsonSuite) Cleanup(ctx context.Context, cfg *suite.Config) error {
+	err := s.programSets.Cleanup(ctx, cfg)
+	if s.inputDir != "" {
+		os.RemoveAll(s.inputDir)
+		s.inputDir = ""
+	}
+	return err
+}
+
+// selectJSONShapes returns the shapes named in the comma-separated list, or
+// all of them for "all"
+func selectJSONShapes(list string) ([]inputgen.JSONShape, error) {
+	if list == "" || list == "all" {
+		return inputgen.JSONShapes, nil
+	}
+	var selected []inputgen.JSONShape
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, shape := range inputgen.JSONShapes {
+			if shape.Name == name {
+				selected = append(selected, shape)
+				found = true
+				break
+			}
+		}
+		if !found {
+			var names []string
+			for _, shape := range inputgen.JSONShapes {
+				names = append(names, shape.Name)
+			}
+			return nil, fmt.Errorf("unknown shape: %s (valid: %s)", name, strings.Join(names, ", "))
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,48 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+)
+
+// collectMetrics gathers the metrics programs report about themselves
+// (e.g. ns per FFI call) when they are timed by poop or hyperfine, which
+// discard program output. Every variant is run runs more times, capturing
+// the output; variants that report no metrics are run only once.
+func collectMetrics(ctx context.Context, w io.Writer, runs int, langs []program) map[string][]metrics.Summary {
+	reported := make(map[string][]metrics.Summary)
+	announced := false
+	for _, lang := range langs {
+		if ctx.Err() != nil {
+			break
+		}
+		var samples metrics.Samples
+		for i := 0; i < runs; i++ {
+			output, err := measure.Output(ctx, lang.dir, lang.runCmd, lang.runTimeout())
+			if err != nil {
+				fmt.Fprintf(w, "%-20s: WARNING: metrics run failed: %v\n", lang.name, err)
+				break
+			}
+			found := metrics.Parse(output)
+			if len(found) == 0 {
+				break
+			}
+			if !announced {
+				fmt.Fprintf(w, "\nCollecting reported metrics (%d runs)...\n", runs)
+				fmt.Fprintln(w, strings.Repeat("=", 80))
+				announced = true
+			}
+			samples.Add(found)
+		}
+		if summaries := samples.Summarize(); len(summaries) > 0 {
+			fmt.Fprintf(w, "%-20s: %d metrics\n", lang.name, len(summaries))
+			reported[lang.name] = summaries
+		}
+	}
+	return reported
+}
@@ -0,0 +1,312 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+)
+
+// runPhaseTimings times the compile and run phases of every variant
+// separately within each iteration, running the iterations of the variants
+// in the order of opts. In compile and exec mode (builtin timer) only the
+// phase of the mode is timed. Variants with a fullHotCmd (e.g. go run) in
+// full-hot mode are timed as a whole; their compile share is estimated by
+// timing compileCmd on its own in the same iteration, capped at the total.
+// Once ctx is done, the remaining variants are recorded as interrupted,
+// those cut short with the runs they completed. Every variant and measured run is reported to prog.
+func runPhaseTimings(ctx context.Context, w io.Writer, opts suite.Options, langs []program, prog progress) []results.Variant {
+	variants := make([]results.Variant, len(langs))
+	samples := make([][]measure.Phases, len(langs))
+	reported := make([]metrics.Samples, len(langs))
+
+	iterations{
+		start: func(v int) { prog.started(langs[v].name) },
+		iterate: func(v, i int) error {
+			p, output, err := timePhases(ctx, opts.Mode, langs[v])
+			if err != nil || i < opts.Warmup {
+				return err
+			}
+			found := metrics.Parse(output)
+			samples[v] = append(samples[v], p)
+			reported[v].Add(found)
+			prog.sample(langs[v].name, len(samples[v]), phaseSample(opts.Mode, p, found))
+			return nil
+		},
+		done: func(v int, err error) {
+			variants[v] = phaseVariant(w, opts, langs[v], samples[v], reported[v], err)
+			prog.finished(variants[v])
+		},
+	}.run(ctx, opts, len(langs), opts.Warmup+opts.Runs)
+	return variants
+}
+
+// phaseVariant summarises the samples of lang, timed until err. An
+// interrupted variant keeps the runs it completed.
+func phaseVariant(w io.Writer, opts suite.Options, lang program, samples []measure.Phases, reported metrics.Samples, err error) results.Variant {
+	variant := results.Variant{Name: lang.name, Status: results.StatusOK}
+	if err != nil {
+		variant = variantFailure(lang.name, err)
+		if variant.Status != results.StatusInterrupted {
+			fmt.Fprintf(w, "%-20s: %s\n%s\n", lang.name, variant.Status, variant.Error)
+			return variant
+		}
+		if len(samples) == 0 {
+			return variant
+		}
+	}
+
+	var compile, run, total []time.Duration
+	for _, p := range samples {
+		compile = append(compile, p.Compile)
+		run = append(run, p.Run)
+		total = append(total, p.Total)
+	}
+	compileStats := measure.Summarize(compile)
+	runStats := measure.Summarize(run)
+	totalStats := measure.Summarize(total)
+	// compile and exec mode only time one of the two phases, and
+	// interpreted variants have no compile phase
+	if opts.Mode != "exec" && lang.compileCmd != "" {
+		variant.Compile = &compileStats
+	}
+	if opts.Mode != "compile" {
+		variant.Run = &runStats
+	}
+	variant.Total = &totalStats
+	variant.CompileEstimated = opts.Mode == "full-hot" && lang.fullHotCmd != ""
+	variant.Metrics = reported.Summarize()
+
+	if variant.Status == results.StatusOK {
+		fmt.Fprintf(w, "%-20s: total %s\n", lang.name, totalStats)
+	} else {
+		fmt.Fprintf(w, "%-20s: total %s (%d of %d runs)\n", lang.name, totalStats, len(samples), opts.Runs)
+	}
+	return variant
+}
+
+// timePhases runs one iteration of lang in mode and returns the output of
+// its run step
+func timePhases(ctx context.Context, mode string, lang program) (measure.Phases, string, error) {
+	var p measure.Phases
+
+	if mode == "compile" {
+		// Prepare a clean build, then time the compile step alone
+		if _, err := measure.Shell(ctx, lang.dir, lang.cleanCmd, lang.prepareTimeout()); err != nil {
+			return p, "", err
+		}
+		compile, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout())
+		return measure.Phases{Compile: compile, Total: compile}, "", err
+	}
+
+	if lang.compileCmd == "" || mode == "exec" {
+		// Interpreted language or prebuilt binary - just run
+		run, output, err := measure.TimedOutput(ctx, lang.dir, lang.runCmd, lang.runTimeout())
+		return measure.Phases{Run: run, Total: run}, output, err
+	}
+
+	if mode == "full-cold" && lang.cleanCmd != "" {
+		if _, err := measure.Shell(ctx, lang.dir, lang.cleanCmd, lang.prepareTimeout()); err != nil {
+			return p, "", err
+		}
+	}
+
+	if mode == "full-hot" && lang.fullHotCmd != "" {
+		total, output, err := measure.TimedOutput(ctx, lang.dir, lang.fullHotCmd, lang.compileTimeout()+lang.runTimeout())
+		if err != nil {
+			return p, "", err
+		}
+		compile, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout())
+		if err != nil {
+			return p, "", err
+		}
+		// The separate build can take longer than the go run it
+		// estimates; cap it so the phases never add up to more than
+		// the total
+		if compile > total {
+			compile = total
+		}
+		p.Total = total
+		p.Compile = compile
+		p.Run = total - compile
+		return p, output, nil
+	}
+
+	compile, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout())
+	if err != nil {
+		return p, "", err
+	}
+	run, output, err := measure.TimedOutput(ctx, lang.dir, lang.runCmd, lang.runTimeout())
+	if err != nil {
+		return p, "", err
+	}
+	p.Compile = compile
+	p.Run = run
+	p.Total = compile + run
+	return p, output, nil
+}
+
+func printPhaseSummary(w io.Writer, variants []results.Variant) {
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "PHASE TIMINGS (mean ± stddev)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-20s %20s %20s %20s\n", "Language", "Compile", "Run", "Total")
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+
+	estimated := false
+	for _, v := range variants {
+		if v.Status != results.StatusOK {
+			fmt.Fprintf(w, "%-20s %20s %20s %20s\n", v.Name, "N/A", "N/A", v.Status)
+			continue
+		}
+		compile, run := "-", "-"
+		if v.Compile != nil {
+			compile = v.Compile.String()
+		}
+		if v.CompileEstimated {
+			compile += "*"
+			estimated = true
+		}
+		if v.Run != nil {
+			run = v.Run.String()
+		}
+		fmt.Fprintf(w, "%-20s %20s %20s %20s\n", v.Name, compile, run, v.Total.String())
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	if estimated {
+		fmt.Fprintln(w, "* compile time estimated from a separate build (capped at total); run = total - compile")
+	}
+}
+
+// printScalingSummary prints the mean total time of every variant for each
+// input size of a sweep
+func printScalingSummary(w io.Writer, suites []*results.Suite) {
+	if len(suites) == 0 {
+		return
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, s := range suites {
+		for _, v := range s.Variants {
+			if !seen[v.Name] {
+				seen[v.Name] = true
+				names = append(names, v.Name)
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintf(w, "SCALING: %s (mean total time, ms)\n", suites[0].Suite)
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-20s", "Language")
+	for _, s := range suites {
+		fmt.Fprintf(w, " %14s", "size="+s.Params["size"])
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%-20s", name)
+		for _, s := range suites {
+			cell := "-"
+			for _, v := range s.Variants {
+				if v.Name != name {
+					continue
+				}
+				if v.Status != results.StatusOK {
+					cell = v.Status
+				} else if v.Total != nil {
+					cell = fmt.Sprintf("%.2f", v.Total.Mean)
+				}
+			}
+			fmt.Fprintf(w, " %14s", cell)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
+
+// printCallShapeMatrix prints the per-call time each FFI sub-benchmark
+// reported (its *_per_call metric) as a call shape × language matrix
+func printCallShapeMatrix(w io.Writer, suites []*results.Suite) {
+	if len(suites) == 0 {
+		return
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, s := range suites {
+		for _, v := range s.Variants {
+			if !seen[v.Name] {
+				seen[v.Name] = true
+				names = append(names, v.Name)
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "FFI CALL SHAPES (mean time per call)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-13s", "Shape")
+	for _, name := range names {
+		fmt.Fprintf(w, " %10s", name)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+
+	for _, s := range suites {
+		fmt.Fprintf(w, "%-13s", strings.TrimPrefix(s.Suite, "ffi/"))
+		for _, name := range names {
+			cell := "-"
+			for _, v := range s.Variants {
+				if v.Name != name {
+					continue
+				}
+				if v.Status != results.StatusOK {
+					cell = v.Status
+					continue
+				}
+				for _, m := range v.Metrics {
+					if strings.HasSuffix(m.Name, "_per_call") {
+						cell = fmt.Sprintf("%.2f %s", m.Mean, m.Unit)
+					}
+				}
+			}
+			fmt.Fprintf(w, " %10s", cell)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
+
+// printMetricsSummary prints the metrics reported by the programs
+// themselves, if any
+func printMetricsSummary(w io.Writer, variants []results.Variant) {
+	reported := false
+	for _, v := range variants {
+		reported = reported || len(v.Metrics) > 0
+	}
+	if !reported {
+		return
+	}
+
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "REPORTED METRICS (mean ± stddev)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-20s %-30s %27s\n", "Language", "Metric", "Value")
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+	for _, v := range variants {
+		for _, m := range v.Metrics {
+			value := fmt.Sprintf("%.2f ± %.2f %s", m.Mean, m.StdDev, m.Unit)
+			fmt.Fprintf(w, "%-20s %-30s %27s\n", v.Name, m.Name, value)
+		}
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
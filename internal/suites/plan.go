@@ -0,0 +1,203 @@
+package suites
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benchmarks/internal/suite"
+	"github.com/benchmarks/internal/workspace"
+)
+
+// planStep describes a command of a plan. A zero timeout is left out, as
+// is a repeat count of one.
+func planStep(phase, action, command string, timeout time.Duration, repeat int) suite.PlanStep {
+	step := suite.PlanStep{Phase: phase, Action: action, Command: command}
+	if timeout > 0 {
+		step.Timeout = timeout.String()
+	}
+	if repeat > 1 {
+		step.Repeat = repeat
+	}
+	return step
+}
+
+// plan describes every set as prepare, verify and run would take it
+func (sets programSets) plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	tool, err := resolveTool(cfg.Log, cfg.Options)
+	if err != nil {
+		return nil, err
+	}
+	var benchmarks []suite.PlanBenchmark
+	for _, set := range sets {
+		benchmarks = append(benchmarks, set.plan(cfg, tool))
+	}
+	return benchmarks, nil
+}
+
+// plan describes what prepare, verify and run execute for the set with the
+// resolved tool
+func (p *programSet) plan(cfg *suite.Config, tool string) suite.PlanBenchmark {
+	b := suite.PlanBenchmark{Name: p.name, Params: p.params, Mode: cfg.Mode, Tool: tool, Order: suite.OrderSequential, Inputs: p.inputs}
+	if tool == "builtin" {
+		b.Order = cfg.DescribeOrder()
+	}
+
+	var langs []program
+	for _, lang := range selectPrograms(cfg, p.programs) {
+		if cfg.Mode == "compile" && lang.compileCmd == "" {
+			b.Skipped = append(b.Skipped, suite.PlanSkip{Name: lang.name, Reason: "interpreted, no compilation"})
+			continue
+		}
+		langs = append(langs, lang)
+	}
+	b.Skipped = append(b.Skipped, unselected(cfg, p.programs)...)
+
+	runDir := workspace.TempPattern(p.name)
+	envs := make(map[string][]string)
+	for i, lang := range langs {
+		ws := workspace.Plan(runDir, lang.name, lang.dir)
+		langs[i].dir = ws.Dir
+		for _, c := range coldCaches(cfg.Mode, &langs[i], ws) {
+			envs[lang.name] = append(envs[lang.name], c.Env+"="+c.Path)
+		}
+	}
+
+	outputChecked := false
+	if cfg.Mode != "compile" && hasReference(p.programs) {
+		for _, lang := range langs {
+			outputChecked = outputChecked || lang.reference
+		}
+	}
+	probed := tool != "builtin" && !(outputChecked && cfg.Mode == "exec")
+	iterations := cfg.Warmup + cfg.Runs
+
+	for _, lang := range langs {
+		v := suite.PlanVariant{Name: lang.name, Source: p.source(lang.name), Dir: lang.dir, Env: envs[lang.name]}
+		add := func(step suite.PlanStep) { v.Steps = append(v.Steps, step) }
+
+		if cfg.Mode == "exec" && lang.compileCmd != "" {
+			add(precompileStep(cfg.Options, lang))
+		}
+
+		if outputChecked {
+			if cfg.Mode != "exec" && lang.compileCmd != "" {
+				add(planStep(suite.PhaseVerify, "compile", lang.compileCmd, lang.compileTimeout(), 1))
+			}
+			action := "check output"
+			if lang.reference {
+				action = "reference output"
+			}
+			add(planStep(suite.PhaseVerify, action, lang.runCmd, lang.runTimeout(), 1))
+		}
+		if probed {
+			if cfg.Mode == "compile" {
+				if lang.cleanCmd != "" {
+					add(planStep(suite.PhaseVerify, "probe: clean", lang.cleanCmd, lang.prepareTimeout(), 1))
+				}
+				add(planStep(suite.PhaseVerify, "probe: compile", lang.compileCmd, lang.compileTimeout(), 1))
+			} else {
+				add(planStep(suite.PhaseVerify, "probe: run", lang.runCmd, lang.runTimeout(), 1))
+			}
+		}
+
+		if tool == "builtin" {
+			for _, step := range phaseSteps(cfg.Mode, lang) {
+				step.Repeat = iterations
+				add(step)
+			}
+		} else {
+			timedBy := "timed by " + tool
+			if cfg.Mode == "compile" {
+				if lang.cleanCmd != "" {
+					add(planStep(suite.PhaseRun, "clean before each compile", lang.cleanCmd, 0, iterations))
+				}
+				add(planStep(suite.PhaseRun, "compile, "+timedBy, lang.compileCmd, 0, iterations))
+			} else {
+				add(planStep(suite.PhaseRun, "run, "+timedBy, lang.runCmd, 0, iterations))
+				if cfg.MetricRuns > 0 {
+					add(planStep(suite.PhaseRun, "collect reported metrics, once if it reports none", lang.runCmd, lang.runTimeout(), cfg.MetricRuns))
+				}
+			}
+		}
+		b.Variants = append(b.Variants, v)
+	}
+
+	if tool != "builtin" && len(langs) > 0 {
+		export := filepath.Join(os.TempDir(), "benchrunner-hyperfine-*.json")
+		b.ToolCommand = append([]string{tool}, benchToolArgs(cfg.Options, tool, langs, export)...)
+	}
+	return b
+}
+
+// unselected lists the programs the targets of cfg don't select
+func unselected(cfg *suite.Config, programs []program) []suite.PlanSkip {
+	var skipped []suite.PlanSkip
+	for _, lang := range programs {
+		if v := lang.variant(); !cfg.Matches(v) {
+			skipped = append(skipped, suite.PlanSkip{Name: lang.name, Reason: cfg.NotSelected(v)})
+		}
+	}
+	return skipped
+}
+
+// precompileStep describes how precompile builds lang
+func precompileStep(opts suite.Options, lang program) suite.PlanStep {
+	action := "compile"
+	if !opts.NoBuildCache && lang.binaryPath != "" {
+		action = "compile, unless in the build cache"
+	}
+	return planStep(suite.PhasePrepare, action, lang.compileCmd, lang.compileTimeout(), 1)
+}
+
+// planVariants places the programs in workspaces under the pattern of the
+// suite's temporary directory and describes each with steps
+func planVariants(suiteName string, langs []program, steps func(lang program) []suite.PlanStep) []suite.PlanVariant {
+	runDir := workspace.TempPattern(suiteName)
+	var variants []suite.PlanVariant
+	for _, lang := range langs {
+		source := lang.dir
+		lang.dir = workspace.Plan(runDir, lang.name, source).Dir
+		variants = append(variants, suite.PlanVariant{Name: lang.name, Source: source, Dir: lang.dir, Steps: steps(lang)})
+	}
+	return variants
+}
+
+// source returns the source directory of a variant of the set
+func (p *programSet) source(name string) string {
+	for _, lang := range p.programs {
+		if lang.name == name {
+			return lang.dir
+		}
+	}
+	return ""
+}
+
+// phaseSteps describes the commands timePhases runs in one iteration of
+// lang in mode
+func phaseSteps(mode string, lang program) []suite.PlanStep {
+	clean := planStep(suite.PhaseRun, "clean", lang.cleanCmd, lang.prepareTimeout(), 1)
+	compile := planStep(suite.PhaseRun, "compile (timed)", lang.compileCmd, lang.compileTimeout(), 1)
+	run := planStep(suite.PhaseRun, "run (timed)", lang.runCmd, lang.runTimeout(), 1)
+
+	switch {
+	case mode == "compile":
+		if lang.cleanCmd == "" {
+			return []suite.PlanStep{compile}
+		}
+		return []suite.PlanStep{clean, compile}
+	case lang.compileCmd == "" || mode == "exec":
+		return []suite.PlanStep{run}
+	}
+
+	var steps []suite.PlanStep
+	if mode == "full-cold" && lang.cleanCmd != "" {
+		steps = append(steps, clean)
+	}
+	if mode == "full-hot" && lang.fullHotCmd != "" {
+		return append(steps,
+			planStep(suite.PhaseRun, "compile and run (timed)", lang.fullHotCmd, lang.compileTimeout()+lang.runTimeout(), 1),
+			planStep(suite.PhaseRun, "compile (timed, estimates the compile share)", lang.compileCmd, lang.compileTimeout(), 1))
+	}
+	return append(steps, compile, run)
+}
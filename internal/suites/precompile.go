@@ -0,0 +1,94 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/benchmarks/internal/buildcache"
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/workspace"
+)
+
+// precompile builds every compiled variant for exec mode, running up to
+// jobs builds concurrently. Each variant has its own workspace, so builds
+// are independent. When cache is non-nil, unchanged binaries are restored
+// from it instead of being rebuilt. It returns the build error of every
+// variant that failed or timed out.
+func precompile(ctx context.Context, w io.Writer, langs []program, workspaces map[string]*workspace.Workspace, cache *buildcache.Cache, jobs int) map[string]error {
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	errs := make(map[string]error)
+	sem := make(chan struct{}, jobs)
+
+	for _, lang := range langs {
+		if lang.compileCmd == "" {
+			fmt.Fprintf(w, "%-20s: interpreted (no build needed)\n", lang.name)
+			continue
+		}
+
+		wg.Add(1)
+		go func(lang program) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			status, err := buildVariant(ctx, lang, workspaces[lang.name], cache)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				fmt.Fprintf(w, "%-20s: %s\n%v\n", lang.name, variantFailure(lang.name, err).Status, err)
+				errs[lang.name] = err
+				return
+			}
+			fmt.Fprintf(w, "%-20s: %s\n", lang.name, status)
+		}(lang)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+// buildVariant restores lang's build artifacts from the cache or compiles it, and
+// returns a short status line for the log
+func buildVariant(ctx context.Context, lang program, ws *workspace.Workspace, cache *buildcache.Cache) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	var key string
+	if cache != nil && lang.binaryPath != "" {
+		k, err := cache.Key(ws.Root, lang.compileCmd, lang.artifacts())
+		if err != nil {
+			return "", err
+		}
+		key = k
+		hit, err := cache.Restore(key, lang.dir)
+		if err != nil {
+			return "", err
+		}
+		if hit {
+			return fmt.Sprintf("cached (%s)", key[:12]), nil
+		}
+	}
+
+	elapsed, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout())
+	if err != nil {
+		return "", err
+	}
+	status := fmt.Sprintf("compiled in %.2fs", elapsed.Seconds())
+
+	if key != "" {
+		if err := cache.Store(key, lang.dir, lang.artifacts()); err != nil {
+			status += fmt.Sprintf(" (WARNING: not cached: %v)", err)
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,585 @@
+// Package suites holds the benchmark suites of the repository. Every suite
+// registers itself with the suite registry, which turns it into a
+// "benchrunner run" subcommand.
+package suites
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/buildcache"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/benchmarks/internal/workspace"
+)
+
+// modeHelp describes the --mode flag in the help of the suites that take it
+const modeHelp = `Modes:
+  compile    - Benchmark compilation time only (cold builds)
+  full-cold  - Benchmark compilation + execution (cold builds, no cache)
+  full-hot   - Benchmark compilation + execution (hot builds, cache allowed)
+  exec       - Benchmark execution time only (pre-compiled)
+
+full-cold and full-hot are timed by the runner itself, reporting compile,
+run and total time per variant.`
+
+// program defines a variant's build and run configuration
+type program struct {
+	name       string
+	dir        string
+	compileCmd string            // command to compile
+	runCmd     string            // command to run the binary
+	binaryPath string            // path to the compiled binary (relative to dir)
+	outputs    []string          // optional: further build outputs the run needs (globs relative to dir)
+	cleanCmd   string            // command to clean build artifacts
+	shared     []string          // optional: files of dir's parent the variant refers to (e.g. ../hotpath.cpp)
+	fullHotCmd string            // optional: command for full-hot mode (e.g., go run)
+	timeouts   stepTimeouts      // optional: per-variant step timeouts
+	reference  bool              // optional: output the other variants are checked against
+	tags       map[string]string // optional: tags overriding the derived ones (see variant)
+
+	criterionDir string // optional: criterion output directory (relative to dir) holding the timings
+}
+
+// artifacts returns the build outputs of p to cache: its binary and any
+// further outputs
+func (p program) artifacts() []string {
+	return append([]string{p.binaryPath}, p.outputs...)
+}
+
+// variantNames lists the variants of one or more program tables, in order
+// and without duplicates
+func variantNames(tables ...[]program) []suite.Variant {
+	var variants []suite.Variant
+	seen := make(map[string]bool)
+	for _, langs := range tables {
+		for _, lang := range langs {
+			if !seen[lang.name] {
+				seen[lang.name] = true
+				variants = append(variants, lang.variant())
+			}
+		}
+	}
+	return variants
+}
+
+// anyMatches reports whether the targets of cfg select one of langs
+func anyMatches(cfg *suite.Config, langs []program) bool {
+	for _, lang := range langs {
+		if cfg.Matches(lang.variant()) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectPrograms returns the variants of langs selected by the targets of
+// cfg, with their step timeouts resolved
+func selectPrograms(cfg *suite.Config, langs []program) []program {
+	var selected []program
+	for _, lang := range langs {
+		if cfg.Matches(lang.variant()) {
+			lang.timeouts = lang.timeouts.withDefaults(cfg.Options)
+			selected = append(selected, lang)
+		}
+	}
+	return selected
+}
+
+// openBuildCache returns the build cache, or nil if it is disabled
+func openBuildCache(opts suite.Options) (*buildcache.Cache, error) {
+	if opts.NoBuildCache {
+		return nil, nil
+	}
+	dir, err := buildcache.DefaultDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate build cache: %w", err)
+	}
+	return buildcache.New(dir), nil
+}
+
+// getBenchmarkTool resolves the --tool flag. "auto" picks poop if available,
+// otherwise hyperfine, otherwise the runner's builtin timer.
+func getBenchmarkTool(w io.Writer, tool string) (string, error) {
+	switch tool {
+	case "builtin":
+		return "builtin", nil
+	case "poop", "hyperfine":
+		if _, err := exec.LookPath(tool); err != nil {
+			return "", fmt.Errorf("%s not found", tool)
+		}
+		return tool, nil
+	case "auto":
+		if _, err := exec.LookPath("poop"); err == nil {
+			return "poop", nil
+		}
+		if _, err := exec.LookPath("hyperfine"); err == nil {
+			return "hyperfine", nil
+		}
+		fmt.Fprintln(w, "Neither poop nor hyperfine found, using the builtin timer")
+		return "builtin", nil
+	}
+	return "", fmt.Errorf("invalid tool: %s (valid: auto, poop, hyperfine, builtin)", tool)
+}
+
+// programSet is one benchmark of a set of program variants: a whole suite,
+// or one of its sub-benchmarks (a kernel at one input size, an FFI call
+// shape). Its methods follow the suite phases. Variants that fail a phase
+// are recorded in the result and dropped from the later phases.
+type programSet struct {
+	name     string            // results suite name, e.g. "compute/n-body"
+	title    string            // optional: printed at the start of every phase
+	group    string            // optional: sets of a group are summarised together
+	params   map[string]string // recorded with the results, e.g. the input size
+	programs []program         // every variant, selected or not
+	inputs   []string          // files the suite generates for the programs, for the plan
+	progress progress          // reports the variants to the run's progress callback
+
+	tool          string
+	phaseTimed    bool
+	runDir        string
+	workspaces    map[string]*workspace.Workspace
+	coldCaches    map[string][]workspace.Cache
+	langs         []program // selected variants still in the run
+	result        *results.Suite
+	outputChecked bool
+	err           error // why the set could not be prepared
+}
+
+// label names the set in errors: its name and parameters
+func (p *programSet) label() string {
+	keys := make([]string, 0, len(p.params))
+	for k := range p.params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	label := p.name
+	for _, k := range keys {
+		label += fmt.Sprintf(" %s=%s", k, p.params[k])
+	}
+	return label
+}
+
+func (p *programSet) printTitle(w io.Writer) {
+	if p.title == "" {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", p.title)
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+}
+
+// dropFailed records failed variants and removes them from the run
+func (p *programSet) dropFailed(errs map[string]error) {
+	var remaining []program
+	for _, lang := range p.langs {
+		if err, ok := errs[lang.name]; ok {
+			variant := variantFailure(lang.name, err)
+			p.progress.finished(variant)
+			p.result.Variants = append(p.result.Variants, variant)
+			continue
+		}
+		remaining = append(remaining, lang)
+	}
+	p.langs = remaining
+}
+
+// coldCaches points the compiler caches of lang's compile command at fresh
+// directories in its workspace for the cold build modes, and returns them
+func coldCaches(mode string, lang *program, ws *workspace.Workspace) []workspace.Cache {
+	if (mode != "compile" && mode != "full-cold") || lang.compileCmd == "" {
+		return nil
+	}
+	caches := ws.ColdCaches(lang.compileCmd)
+	if len(caches) == 0 {
+		return nil
+	}
+	export := workspace.ExportCmd(caches)
+	lang.compileCmd = joinCmds(export, lang.compileCmd)
+	lang.cleanCmd = joinCmds(export, lang.cleanCmd, workspace.ResetCmd(caches))
+	return caches
+}
+
+// prepare copies every selected variant into its own workspace and, in
+// exec mode, builds it. tool is the resolved benchmark tool.
+func (p *programSet) prepare(ctx context.Context, cfg *suite.Config, tool string) error {
+	p.printTitle(cfg.Log)
+
+	p.tool = tool
+	p.phaseTimed = tool == "builtin"
+	p.progress = progress{cfg: cfg, benchmark: p.name, params: p.params}
+
+	p.langs = nil
+	for _, lang := range selectPrograms(cfg, p.programs) {
+		// Skip interpreted languages only for compile mode
+		if cfg.Mode == "compile" && lang.compileCmd == "" {
+			fmt.Fprintf(cfg.Log, "Skipping %s (interpreted, no compilation)\n", lang.name)
+			continue
+		}
+		p.langs = append(p.langs, lang)
+	}
+	if len(p.langs) == 0 {
+		return fmt.Errorf("no matching language found: %v", cfg.Targets)
+	}
+
+	// Build every variant in its own out-of-tree copy so that compile and
+	// clean commands never touch the checked-in sources
+	runDir, err := workspace.MkdirTemp(p.name)
+	if err != nil {
+		return fmt.Errorf("failed to create build directory: %w", err)
+	}
+	p.runDir = runDir
+
+	p.workspaces = make(map[string]*workspace.Workspace)
+	for i, lang := range p.langs {
+		ws, err := workspace.New(runDir, lang.name, lang.dir, lang.shared)
+		if err != nil {
+			return err
+		}
+		p.workspaces[lang.name] = ws
+		p.langs[i].dir = ws.Dir
+	}
+
+	// Cold builds get fresh, empty compiler caches for every iteration so
+	// that nothing built by an earlier run (or by the user) is reused
+	p.coldCaches = make(map[string][]workspace.Cache)
+	for i, lang := range p.langs {
+		if caches := coldCaches(cfg.Mode, &p.langs[i], p.workspaces[lang.name]); len(caches) > 0 {
+			p.coldCaches[lang.name] = caches
+		}
+	}
+
+	fmt.Fprintf(cfg.Log, "Running %s benchmarks [mode: %s]\n", p.name, cfg.Mode)
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	p.result = &results.Suite{
+		Suite:     p.name,
+		Mode:      cfg.Mode,
+		Tool:      p.tool,
+		Warmup:    cfg.Warmup,
+		Runs:      cfg.Runs,
+		Params:    p.params,
+		Timestamp: time.Now(),
+	}
+	setOrder(p.result, cfg.Options)
+
+	// For exec mode, pre-compile all binaries first
+	if cfg.Mode == "exec" {
+		cache, err := openBuildCache(cfg.Options)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cfg.Log, "Pre-compiling binaries (%d jobs)...\n", cfg.Jobs)
+		p.dropFailed(precompile(ctx, cfg.Log, p.langs, p.workspaces, cache, cfg.Jobs))
+		fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+	}
+	return nil
+}
+
+// verify compares every variant's result with the reference implementation
+// and, for tool runs, checks every variant once under the step timeouts
+func (p *programSet) verify(ctx context.Context, cfg *suite.Config) error {
+	if p.result == nil {
+		return nil
+	}
+	p.printTitle(cfg.Log)
+
+	if cfg.Mode != "compile" && hasReference(p.programs) {
+		fmt.Fprintln(cfg.Log, "Checking output...")
+		errs, checked := checkOutputs(ctx, cfg.Log, cfg.Mode, p.langs)
+		p.dropFailed(errs)
+		p.outputChecked = checked
+		fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+	}
+
+	// The tool itself can't time out single commands, so check every
+	// variant once under the step timeouts before handing it over. In
+	// exec mode the output check already ran every variant that way.
+	if !p.phaseTimed && !(p.outputChecked && cfg.Mode == "exec") {
+		fmt.Fprintln(cfg.Log, "Checking variants...")
+		probeErrs := make(map[string]error)
+		for _, lang := range p.langs {
+			if err := probeVariant(ctx, cfg.Mode, lang); err != nil {
+				fmt.Fprintf(cfg.Log, "%-20s: %s\n%v\n", lang.name, variantFailure(lang.name, err).Status, err)
+				probeErrs[lang.name] = err
+				continue
+			}
+			fmt.Fprintf(cfg.Log, "%-20s: OK\n", lang.name)
+		}
+		p.dropFailed(probeErrs)
+	}
+	return nil
+}
+
+// run times the remaining variants and records the result. It fails if
+// any variant failed or timed out in this or an earlier phase. If ctx is
+// done meanwhile, the variants it cut short are recorded as interrupted.
+func (p *programSet) run(ctx context.Context, cfg *suite.Config) error {
+	if p.result == nil {
+		return nil
+	}
+	p.printTitle(cfg.Log)
+
+	if len(p.coldCaches) > 0 {
+		fmt.Fprintln(cfg.Log, "Isolated caches:")
+		for _, lang := range p.langs {
+			var envs []string
+			for _, c := range p.coldCaches[lang.name] {
+				envs = append(envs, c.Env)
+			}
+			if len(envs) == 0 {
+				envs = append(envs, "none")
+			}
+			fmt.Fprintf(cfg.Log, "%-20s: %s\n", lang.name, strings.Join(envs, ", "))
+		}
+		fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+	}
+
+	if p.phaseTimed {
+		fmt.Fprintf(cfg.Log, "\nTiming with the builtin timer (%d warmup, %d runs, order %s)...\n", cfg.Warmup, cfg.Runs, cfg.DescribeOrder())
+		fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+		p.result.Variants = append(p.result.Variants, runPhaseTimings(ctx, cfg.Log, cfg.Options, p.langs, p.progress)...)
+		printPhaseSummary(cfg.Log, p.result.Variants)
+	} else if len(p.langs) > 0 {
+		for _, lang := range p.langs {
+			p.progress.started(lang.name)
+		}
+		timed := len(p.result.Variants)
+		stats, err := runBenchTool(ctx, cfg, p.tool, p.langs)
+		for i, lang := range p.langs {
+			if err != nil {
+				p.result.Variants = append(p.result.Variants, variantFailure(lang.name, err))
+				continue
+			}
+			variant := results.Variant{Name: lang.name, Status: results.StatusOK}
+			if stats != nil {
+				variant.Total = stats[i]
+				if cfg.Mode == "compile" {
+					variant.Compile = stats[i]
+				} else {
+					variant.Run = stats[i]
+				}
+			}
+			p.result.Variants = append(p.result.Variants, variant)
+		}
+		if err != nil {
+			fmt.Fprintf(cfg.Log, "WARNING: %v\n", err)
+		} else if cfg.Mode == "exec" {
+			reported := collectMetrics(ctx, cfg.Log, cfg.MetricRuns, p.langs)
+			for i := range p.result.Variants {
+				if m, ok := reported[p.result.Variants[i].Name]; ok {
+					p.result.Variants[i].Metrics = m
+				}
+			}
+		}
+		for _, variant := range p.result.Variants[timed:] {
+			p.progress.finished(variant)
+		}
+	}
+
+	printMetricsSummary(cfg.Log, p.result.Variants)
+
+	for i := range p.result.Variants {
+		for _, c := range p.coldCaches[p.result.Variants[i].Name] {
+			p.result.Variants[i].IsolatedCaches = append(p.result.Variants[i].IsolatedCaches, c.Env)
+		}
+	}
+
+	// Report binary sizes for compile modes
+	if cfg.Mode != "exec" {
+		p.recordBinarySizes(cfg.Log)
+	}
+
+	cfg.Record(p.result)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	failed := 0
+	for _, v := range p.result.Variants {
+		if v.Status != results.StatusOK {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d variants failed or timed out", failed, len(p.result.Variants))
+	}
+	return nil
+}
+
+// recordBinarySizes prints the size of every variant's build output and
+// records it with the results
+func (p *programSet) recordBinarySizes(w io.Writer) {
+	fmt.Fprintln(w, "\nBinary sizes:")
+	fmt.Fprintln(w, strings.Repeat("-", 40))
+	fmt.Fprintf(w, "%-20s %10s\n", "Language", "Size")
+	fmt.Fprintln(w, strings.Repeat("-", 40))
+
+	for _, lang := range p.langs {
+		if lang.binaryPath == "" {
+			continue
+		}
+		binaryFullPath := filepath.Join(lang.dir, lang.binaryPath)
+		if info, err := os.Stat(binaryFullPath); err == nil {
+			size := info.Size()
+			for i := range p.result.Variants {
+				if p.result.Variants[i].Name == lang.name {
+					p.result.Variants[i].BinarySize = size
+				}
+			}
+			var sizeStr string
+			if size >= 1024*1024 {
+				sizeStr = fmt.Sprintf("%.2f MB", float64(size)/(1024*1024))
+			} else if size >= 1024 {
+				sizeStr = fmt.Sprintf("%.2f KB", float64(size)/1024)
+			} else {
+				sizeStr = fmt.Sprintf("%d B", size)
+			}
+			fmt.Fprintf(w, "%-20s %10s\n", lang.name, sizeStr)
+		} else {
+			fmt.Fprintf(w, "%-20s %10s\n", lang.name, "N/A")
+		}
+	}
+	fmt.Fprintln(w, strings.Repeat("-", 40))
+}
+
+// cleanup keeps the build artifacts if requested and removes the build
+// directory
+func (p *programSet) cleanup(cfg *suite.Config) error {
+	if p.runDir == "" {
+		return nil
+	}
+	if cfg.KeepDir != "" {
+		dst := filepath.Join(cfg.KeepDir, p.name)
+		fmt.Fprintf(cfg.Log, "\nKeeping build artifacts in %s\n", dst)
+		for _, lang := range p.langs {
+			if lang.binaryPath == "" {
+				continue
+			}
+			if err := p.workspaces[lang.name].Keep(lang.binaryPath, filepath.Join(dst, lang.name)); err != nil {
+				fmt.Fprintf(cfg.Log, "WARNING: failed to keep %s artifact: %v\n", lang.name, err)
+			}
+		}
+	}
+	err := os.RemoveAll(p.runDir)
+	p.runDir = ""
+	return err
+}
+
+// programSets takes several program sets through the suite phases, each
+// phase over all sets before the next one starts. Suites embed it for
+// Verify and Cleanup and call prepare and run from their own Prepare and
+// Run. A set that fails to prepare is reported and skipped rather than
+// stopping the others; its error is returned by run.
+type programSets []*programSet
+
+// resolveTool validates the mode and returns the tool that times it.
+// full-cold and full-hot are timed phase by phase by the runner itself;
+// the other modes are driven by poop or hyperfine unless the builtin timer
+// is selected. poop and hyperfine run every iteration of a command before
+// the next, so only the builtin timer interleaves the variants.
+func resolveTool(w io.Writer, opts suite.Options) (string, error) {
+	validModes := map[string]bool{"compile": true, "full-cold": true, "full-hot": true, "exec": true}
+	if !validModes[opts.Mode] {
+		return "", fmt.Errorf("invalid mode: %s (valid: compile, full-cold, full-hot, exec)", opts.Mode)
+	}
+	if opts.Mode != "compile" && opts.Mode != "exec" {
+		return "builtin", nil
+	}
+	if opts.Order == suite.OrderSequential {
+		return getBenchmarkTool(w, opts.Tool)
+	}
+	switch opts.Tool {
+	case "auto", "builtin":
+		return "builtin", nil
+	case "poop", "hyperfine":
+		return "", fmt.Errorf("%s can't run the variants in %s order; use --tool builtin", opts.Tool, opts.Order)
+	}
+	return getBenchmarkTool(w, opts.Tool)
+}
+
+func (sets programSets) prepare(ctx context.Context, cfg *suite.Config) error {
+	tool, err := resolveTool(cfg.Log, cfg.Options)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, set := range sets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := set.prepare(ctx, cfg, tool); err != nil {
+			if len(sets) > 1 {
+				fmt.Fprintf(cfg.Log, "ERROR: %v\n", err)
+			}
+			set.err = err
+			set.result = nil
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(sets) {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// Verify verifies every prepared set.
+func (sets programSets) Verify(ctx context.Context, cfg *suite.Config) error {
+	for _, set := range sets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := set.verify(ctx, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run runs every prepared set. After each run of consecutive sets of the
+// same group, summarize (if non-nil) is called with their results. Once
+// ctx is done, run stops after the set it interrupted.
+func (sets programSets) run(ctx context.Context, cfg *suite.Config, summarize func(io.Writer, []*results.Suite)) error {
+	var errs []error
+	var group []*results.Suite
+	for i, set := range sets {
+		if set.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", set.label(), set.err))
+		} else if err := set.run(ctx, cfg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", set.label(), err))
+		}
+		if set.result != nil {
+			group = append(group, set.result)
+		}
+		interrupted := ctx.Err() != nil
+		if interrupted || i == len(sets)-1 || sets[i+1].group != set.group {
+			if summarize != nil && len(group) > 0 {
+				summarize(cfg.Log, group)
+			}
+			group = nil
+		}
+		if interrupted {
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Cleanup cleans up every set.
+func (sets programSets) Cleanup(ctx context.Context, cfg *suite.Config) error {
+	var errs []error
+	for _, set := range sets {
+		if err := set.cleanup(cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,54 @@
+package suites
+
+import (
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+)
+
+// progress reports the variants of one benchmark to cfg as they are timed.
+// The zero value reports nothing.
+type progress struct {
+	cfg       *suite.Config
+	benchmark string
+	params    map[string]string
+}
+
+func (p progress) emit(e suite.Event) {
+	if p.cfg == nil {
+		return
+	}
+	e.Benchmark = p.benchmark
+	e.Params = p.params
+	p.cfg.Emit(e)
+}
+
+// started reports that a variant starts being timed
+func (p progress) started(variant string) {
+	p.emit(suite.Event{Kind: suite.EventVariantStart, Variant: variant})
+}
+
+// sample reports the timings and metrics of one measured run of a variant
+func (p progress) sample(variant string, iteration int, values []metrics.Metric) {
+	p.emit(suite.Event{Kind: suite.EventSample, Variant: variant, Iteration: iteration, Sample: values})
+}
+
+// finished reports the outcome of a variant
+func (p progress) finished(v results.Variant) {
+	p.emit(suite.Event{Kind: suite.EventVariantDone, Variant: v.Name, Outcome: &v})
+}
+
+// phaseSample converts the phases of a run timed in mode into sample
+// values, followed by the metrics the run reported
+func phaseSample(mode string, p measure.Phases, reported []metrics.Metric) []metrics.Metric {
+	var values []metrics.Metric
+	if mode != "exec" {
+		values = append(values, metrics.Metric{Name: "compile", Value: durationMs(p.Compile), Unit: "ms"})
+	}
+	if mode != "compile" {
+		values = append(values, metrics.Metric{Name: "run", Value: durationMs(p.Run), Unit: "ms"})
+	}
+	values = append(values, metrics.Metric{Name: "total", Value: durationMs(p.Total), Unit: "ms"})
+	return append(values, reported...)
+}
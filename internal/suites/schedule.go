@@ -0,0 +1,69 @@
+package suites
+
+import (
+	"context"
+
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+)
+
+// iterations drives the iterations of the variants of a benchmark in the
+// order of the options (see suite.Options.Schedule)
+type iterations struct {
+	// start is called before the first iteration of variant v
+	start func(v int)
+	// iterate runs iteration i, from 0, of variant v
+	iterate func(v, i int) error
+	// done is called after the last iteration of variant v, or with the
+	// error of the iteration that failed; a failed variant runs no further
+	done func(v int, err error)
+}
+
+// run runs count iterations of each of n variants. Once ctx is done no
+// further iteration starts, and the variants not done yet are done with
+// its error.
+func (it iterations) run(ctx context.Context, opts suite.Options, n, count int) {
+	started := make([]bool, n)
+	finished := make([]bool, n)
+	next := make([]int, n) // the next iteration of every variant
+
+	for _, v := range opts.Schedule(n, count) {
+		if ctx.Err() != nil {
+			break
+		}
+		if finished[v] {
+			continue
+		}
+		if !started[v] {
+			started[v] = true
+			it.start(v)
+		}
+		err := it.iterate(v, next[v])
+		next[v]++
+		if err != nil || next[v] == count {
+			finished[v] = true
+			it.done(v, err)
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if finished[v] {
+			continue
+		}
+		if !started[v] && ctx.Err() == nil {
+			it.start(v) // no iterations to run
+		}
+		it.done(v, ctx.Err())
+	}
+}
+
+// setOrder records the order of opts with r, unless it is sequential
+func setOrder(r *results.Suite, opts suite.Options) {
+	if opts.Order == suite.OrderSequential {
+		return
+	}
+	r.Order = opts.Order
+	if opts.Order == suite.OrderRandom {
+		r.OrderSeed = opts.OrderSeed
+	}
+}
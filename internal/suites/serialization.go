@@ -0,0 +1,354 @@
+package suites
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/benchmarks/internal/workspace"
+)
+
+// serializationRecordBytes is the approximate unencoded size of one record,
+// the same estimate benches/serialization_bench.rs gives criterion for its
+// throughput. Throughput is reported against it for every variant so the
+// formats are compared on the same input.
+const serializationRecordBytes = 8 + 20 + 8 + 12 + 64
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "serialization",
+		Short: "Run serialization (encoding) benchmarks",
+		Long: `Benchmark encoding the same records (id, name, value, numbers, data) with
+several serialization formats, for 100, 1000 and 10000 records:
+  - rust: Avro, Protobuf, Cap'n Proto and Fory, driven through the criterion
+    benches in serialization/ (cargo bench)
+  - go: encoding/json, encoding/gob and a hand-rolled protobuf wire encoder
+
+Reports encoding time, throughput and encoded size side by side. The
+criterion variants run under a 30m run timeout of their own.`,
+		Flags: suite.CompileTimeoutFlag | suite.RunTimeoutFlag,
+		New: func(baseDir string) suite.Suite {
+			return &serializationSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// serializationSuite builds every variant once and runs it once; the
+// programs (or criterion) do their own repetitions
+type serializationSuite struct {
+	baseDir string
+
+	runDir     string
+	workspaces map[string]*workspace.Workspace
+	langs      []program // built variants
+	result     *results.Suite
+}
+
+func getSerializationLanguages(baseDir string) []program {
+	serDir := filepath.Join(baseDir, "serialization")
+	return []program{
+		{
+			name:         "rust",
+			dir:          serDir,
+			compileCmd:   "CARGO_TARGET_DIR=target cargo bench --bench serialization_bench --no-run",
+			runCmd:       "CARGO_TARGET_DIR=target cargo bench --bench serialization_bench -- --noplot",
+			timeouts:     stepTimeouts{run: 30 * time.Minute},
+			criterionDir: "target/criterion",
+		},
+		{
+			name:       "go",
+			dir:        filepath.Join(serDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     "./main",
+			binaryPath: "main",
+		},
+	}
+}
+
+func (s *serializationSuite) Variants() []suite.Variant {
+	return variantNames(getSerializationLanguages(s.baseDir))
+}
+
+// Prepare copies every selected variant into a workspace and builds it.
+func (s *serializationSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	runDir, err := workspace.MkdirTemp("serialization")
+	if err != nil {
+		return fmt.Errorf("failed to create build directory: %w", err)
+	}
+	s.runDir = runDir
+
+	fmt.Fprintln(cfg.Log, "Running serialization benchmarks (encoding)")
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	s.result = &results.Suite{
+		Suite:     "serialization",
+		Mode:      "exec",
+		Timestamp: time.Now(),
+	}
+	s.workspaces = make(map[string]*workspace.Workspace)
+	for _, lang := range selectPrograms(cfg, getSerializationLanguages(s.baseDir)) {
+		ws, err := workspace.New(runDir, lang.name, lang.dir, lang.shared)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cfg.Log, "Building %s...\n", lang.name)
+		if _, err := measure.Shell(ctx, ws.Dir, lang.compileCmd, lang.compileTimeout()); err != nil {
+			err = fmt.Errorf("compile failed: %w", err)
+			fmt.Fprintf(cfg.Log, "ERROR: %v\n", err)
+			variant := variantFailure(lang.name, err)
+			progress{cfg: cfg, benchmark: "serialization"}.finished(variant)
+			s.result.Variants = append(s.result.Variants, variant)
+			continue
+		}
+		s.workspaces[lang.name] = ws
+		s.langs = append(s.langs, lang)
+	}
+	return nil
+}
+
+// Plan lists the build and the single run of every selected variant.
+func (s *serializationSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	all := getSerializationLanguages(s.baseDir)
+	b := suite.PlanBenchmark{
+		Name:    "serialization",
+		Mode:    "exec",
+		Tool:    "serialization",
+		Skipped: unselected(cfg, all),
+	}
+	b.Variants = planVariants("serialization", selectPrograms(cfg, all), func(lang program) []suite.PlanStep {
+		return []suite.PlanStep{
+			planStep(suite.PhasePrepare, "compile", lang.compileCmd, lang.compileTimeout(), 1),
+			planStep(suite.PhaseRun, "run once, the program repeats itself", lang.runCmd, lang.runTimeout(), 1),
+		}
+	})
+	return []suite.PlanBenchmark{b}, nil
+}
+
+// Verify does nothing: a variant that reports no results fails in Run.
+func (s *serializationSuite) Verify(ctx context.Context, cfg *suite.Config) error {
+	return nil
+}
+
+func (s *serializationSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	prog := progress{cfg: cfg, benchmark: "serialization"}
+	for i, lang := range s.langs {
+		if err := ctx.Err(); err != nil {
+			variant := variantFailure(lang.name, err)
+			prog.finished(variant)
+			s.result.Variants = append(s.result.Variants, variant)
+			continue
+		}
+		prog.started(lang.name)
+		fmt.Fprintf(cfg.Log, "\n[%d/%d] %s\n", i+1, len(s.langs), lang.name)
+		fmt.Fprintln(cfg.Log, strings.Repeat("-", 80))
+
+		variant, err := runSerializationVariant(ctx, cfg.Log, lang, s.workspaces[lang.name])
+		if err != nil {
+			fmt.Fprintf(cfg.Log, "ERROR: %v\n", err)
+			variant = variantFailure(lang.name, err)
+		}
+		prog.finished(variant)
+		s.result.Variants = append(s.result.Variants, variant)
+	}
+
+	printSerializationSummary(cfg.Log, s.result.Variants)
+	cfg.Record(s.result)
+	return ctx.Err()
+}
+
+func (s *serializationSuite) Cleanup(ctx context.Context, cfg *suite.Config) error {
+	if s.runDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(s.runDir)
+	s.runDir = ""
+	return err
+}
+
+// runSerializationVariant runs one built variant in its workspace.
+// Encoded sizes come from the metric lines it prints; timings come from
+// its metric lines too, or from criterion's output for criterion variants.
+func runSerializationVariant(ctx context.Context, w io.Writer, lang program, ws *workspace.Workspace) (results.Variant, error) {
+	fmt.Fprintf(w, "Running %s (%s)...\n", lang.name, lang.runCmd)
+	output, err := measure.Output(ctx, ws.Dir, lang.runCmd, lang.runTimeout())
+	if err != nil {
+		return results.Variant{}, fmt.Errorf("run failed: %w", err)
+	}
+
+	reported := metrics.Parse(output)
+	if lang.criterionDir != "" {
+		timings, err := readCriterionSamples(ws.Path(lang.criterionDir))
+		if err != nil {
+			return results.Variant{}, err
+		}
+		reported = append(reported, timings...)
+	}
+	if len(reported) == 0 {
+		return results.Variant{}, fmt.Errorf("%s reported no results", lang.name)
+	}
+
+	var samples metrics.Samples
+	samples.Add(withThroughput(reported))
+	return results.Variant{
+		Name:    lang.name,
+		Status:  results.StatusOK,
+		Metrics: samples.Summarize(),
+	}, nil
+}
+
+// readCriterionSamples returns the time per iteration of every sample
+// criterion recorded under dir, as <function>_<input>_encode metrics in ns.
+func readCriterionSamples(dir string) ([]metrics.Metric, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*", "*", "new", "benchmark.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no criterion results in %s", dir)
+	}
+	sort.Strings(paths)
+
+	var timings []metrics.Metric
+	for _, path := range paths {
+		var bench struct {
+			FunctionID string `json:"function_id"`
+			ValueStr   string `json:"value_str"`
+		}
+		if err := readJSON(path, &bench); err != nil {
+			return nil, err
+		}
+		var sample struct {
+			Iters []float64 `json:"iters"`
+			Times []float64 `json:"times"`
+		}
+		if err := readJSON(filepath.Join(filepath.Dir(path), "sample.json"), &sample); err != nil {
+			return nil, err
+		}
+
+		name := strings.ToLower(bench.FunctionID) + "_" + bench.ValueStr + "_encode"
+		for i := range sample.Times {
+			if i >= len(sample.Iters) || sample.Iters[i] == 0 {
+				break
+			}
+			timings = append(timings, metrics.Metric{Name: name, Value: sample.Times[i] / sample.Iters[i], Unit: "ns"})
+		}
+	}
+	return timings, nil
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// withThroughput adds a <format>_<records>_throughput metric (MB/s) for
+// every <format>_<records>_encode sample.
+func withThroughput(reported []metrics.Metric) []metrics.Metric {
+	out := append([]metrics.Metric(nil), reported...)
+	for _, m := range reported {
+		format, records, kind, ok := splitSerializationMetric(m.Name)
+		if !ok || kind != "encode" || m.Value <= 0 {
+			continue
+		}
+		bytesPerNs := float64(records*serializationRecordBytes) / m.Value
+		out = append(out, metrics.Metric{
+			Name:  fmt.Sprintf("%s_%d_throughput", format, records),
+			Value: bytesPerNs * 1e9 / 1e6,
+			Unit:  "MB/s",
+		})
+	}
+	return out
+}
+
+// splitSerializationMetric splits a <format>_<records>_<kind> metric name.
+func splitSerializationMetric(name string) (format string, records int, kind string, ok bool) {
+	parts := strings.Split(name, "_")
+	if len(parts) != 3 {
+		return "", 0, "", false
+	}
+	records, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, "", false
+	}
+	return parts[0], records, parts[2], true
+}
+
+// printSerializationSummary prints every format's encoding time, throughput
+// and encoded size side by side, grouped by the number of records.
+func printSerializationSummary(w io.Writer, variants []results.Variant) {
+	type row struct {
+		records          int
+		format, language string
+		encode           *metrics.Summary
+		throughput, size float64
+	}
+	var rows []*row
+	index := make(map[string]*row)
+	for _, v := range variants {
+		for i, m := range v.Metrics {
+			format, records, kind, ok := splitSerializationMetric(m.Name)
+			if !ok {
+				continue
+			}
+			key := fmt.Sprintf("%d/%s/%s", records, format, v.Name)
+			r := index[key]
+			if r == nil {
+				r = &row{records: records, format: format, language: v.Name}
+				index[key] = r
+				rows = append(rows, r)
+			}
+			switch kind {
+			case "encode":
+				r.encode = &v.Metrics[i]
+			case "throughput":
+				r.throughput = m.Mean
+			case "size":
+				r.size = m.Mean
+			}
+		}
+	}
+	if len(rows) == 0 {
+		return
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].records != rows[j].records {
+			return rows[i].records < rows[j].records
+		}
+		return rows[i].format < rows[j].format
+	})
+
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "SERIALIZATION: encoding (mean ± stddev per batch of records)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-8s %-10s %-9s %22s %14s %12s\n", "Records", "Format", "Language", "Time", "Throughput", "Encoded")
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+	for _, r := range rows {
+		timeCell, throughputCell, sizeCell := "-", "-", "-"
+		if r.encode != nil {
+			timeCell = fmt.Sprintf("%.2f ± %.2f µs", r.encode.Mean/1e3, r.encode.StdDev/1e3)
+			throughputCell = fmt.Sprintf("%.1f MB/s", r.throughput)
+		}
+		if r.size > 0 {
+			sizeCell = fmt.Sprintf("%.0f B", r.size)
+		}
+		fmt.Fprintf(w, "%-8d %-10s %-9s %22s %14s %12s\n", r.records, r.format, r.language, timeCell, throughputCell, sizeCell)
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
@@ -0,0 +1,320 @@
+package suites
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/benchmark"
+	"github.com/benchmarks/internal/builder"
+	"github.com/benchmarks/internal/config"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/server"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "server",
+		Args:  "[api-name]",
+		Short: "Run HTTP server benchmarks",
+		Long:  "Run benchmarks on all or selected HTTP servers",
+		Flags: suite.OrderFlags,
+		New: func(baseDir string) suite.Suite {
+			return &serverSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// serverSuite starts every selected HTTP server in turn and puts it under
+// load with the http_load_test binary
+type serverSuite struct {
+	baseDir     string
+	connections int
+	pipeline    int
+	duration    int
+	rounds      int
+	reuse       bool
+
+	loadTest string // path of the load test binary
+	servers  []config.ServerConfig
+}
+
+func (s *serverSuite) Flags(fs *pflag.FlagSet) {
+	fs.IntVarP(&s.connections, "connections", "c", 100, "Number of connections")
+	fs.IntVarP(&s.pipeline, "pipeline", "p", 1, "Pipeline factor")
+	fs.IntVarP(&s.duration, "duration", "d", 10, "Duration in seconds")
+	fs.IntVar(&s.rounds, "rounds", 1, "Number of load tests per server, each on a freshly started server (in --order)")
+	fs.BoolVar(&s.reuse, "reuse-server", false, "Start each server once for all of its rounds (sequential order only)")
+}
+
+func (s *serverSuite) Variants() []suite.Variant {
+	var variants []suite.Variant
+	for _, srv := range config.GetServers(s.baseDir) {
+		variants = append(variants, suite.Variant{Name: srv.Name, Tags: srv.Tags})
+	}
+	return variants
+}
+
+// Prepare builds the load test binary.
+func (s *serverSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	if err := s.checkRounds(cfg); err != nil {
+		return err
+	}
+	b := builder.New(s.baseDir, cfg.Log)
+	if err := b.Build(); err != nil {
+		return fmt.Errorf("failed to build binary: %w", err)
+	}
+	s.loadTest = b.GetBinaryPath()
+
+	for _, srv := range config.GetServers(s.baseDir) {
+		if cfg.Matches(suite.Variant{Name: srv.Name, Tags: srv.Tags}) {
+			s.servers = append(s.servers, srv)
+		}
+	}
+	if len(s.servers) == 0 {
+		return fmt.Errorf("no servers to benchmark")
+	}
+	return nil
+}
+
+// Plan lists the build of the load tester and, per selected server, how it
+// is started and put under load.
+func (s *serverSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	if err := s.checkRounds(cfg); err != nil {
+		return nil, err
+	}
+	b := builder.New(s.baseDir, cfg.Log)
+	runner := benchmark.NewRunner(b.GetBinaryPath(), s.connections, s.pipeline, s.duration, cfg.Log)
+	plan := suite.PlanBenchmark{Name: "server", Params: s.params(), Mode: "exec", Tool: "http_load_test", Order: cfg.DescribeOrder()}
+
+	var build []suite.PlanStep
+	for _, c := range b.Commands() {
+		step := planStep(suite.PhasePrepare, "build, unless "+c.Unless+" exists", "cd "+c.Dir+" && "+c.Command, 0, 1)
+		build = append(build, step)
+	}
+
+	for _, srv := range config.GetServers(s.baseDir) {
+		if v := (suite.Variant{Name: srv.Name, Tags: srv.Tags}); !cfg.Matches(v) {
+			plan.Skipped = append(plan.Skipped, suite.PlanSkip{Name: srv.Name, Reason: cfg.NotSelected(v)})
+			continue
+		}
+		steps := append([]suite.PlanStep(nil), build...)
+		build = nil // built once, before the first server
+		starts := s.rounds
+		if s.reuse {
+			starts = 1
+		}
+		steps = append(steps,
+			planStep(suite.PhaseRun, fmt.Sprintf("start, wait for port %d", srv.Port), strings.Join(srv.StartCmd, " "), 5*time.Second, starts),
+			planStep(suite.PhaseRun, "load test", strings.Join(runner.Command(srv.Port), " "), runner.Wait(), s.rounds),
+			planStep(suite.PhaseRun, "stop", stopCommand(srv), 0, starts))
+		plan.Variants = append(plan.Variants, suite.PlanVariant{Name: srv.Name, Dir: srv.Dir, Steps: steps})
+	}
+	if len(plan.Variants) == 0 {
+		return nil, fmt.Errorf("no servers to benchmark")
+	}
+	return []suite.PlanBenchmark{plan}, nil
+}
+
+// checkRounds validates --rounds and --reuse-server
+func (s *serverSuite) checkRounds(cfg *suite.Config) error {
+	if s.rounds < 1 {
+		return fmt.Errorf("invalid rounds: %d (must be at least 1)", s.rounds)
+	}
+	if s.reuse && cfg.Order != suite.OrderSequential {
+		return fmt.Errorf("--reuse-server runs the rounds of a server back to back, not in %s order", cfg.Order)
+	}
+	return nil
+}
+
+// stopCommand describes how server.Stop stops srv
+func stopCommand(srv config.ServerConfig) string {
+	if srv.Name == "nginx-static" {
+		return "nginx -p . -c nginx.conf -s stop"
+	}
+	return "kill -KILL -<pgid>"
+}
+
+// Verify does nothing: a server that doesn't come up fails in Run.
+func (s *serverSuite) Verify(ctx context.Context, cfg *suite.Config) error {
+	return nil
+}
+
+// Run puts every server under load s.rounds times, starting it afresh for
+// every round unless --reuse-server keeps it running for all of its
+// rounds. The rounds of the servers run in the order of cfg, e.g. one
+// round of every server after the other with --order interleaved.
+func (s *serverSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	runner := benchmark.NewRunner(s.loadTest, s.connections, s.pipeline, s.duration, cfg.Log)
+	prog := progress{cfg: cfg, benchmark: "server", params: s.params()}
+	if s.rounds > 1 {
+		fmt.Fprintf(cfg.Log, "\nRunning %d rounds per server, order %s\n", s.rounds, cfg.DescribeOrder())
+	}
+
+	var running *server.Server // the server of the current round
+	started := false           // whether a server was started before
+	stop := func() {
+		// Stop server, also when interrupted
+		if running != nil {
+			running.Stop()
+			running = nil
+		}
+	}
+	defer stop()
+
+	rounds := make([][]results.Round, len(s.servers))
+	variants := make([]results.Variant, len(s.servers))
+	iterations{
+		start: func(v int) { prog.started(s.servers[v].Name) },
+		iterate: func(v, round int) error {
+			srvCfg := s.servers[v]
+			if s.rounds > 1 {
+				fmt.Fprintf(cfg.Log, "\n%s: round %d of %d\n", srvCfg.Name, round+1, s.rounds)
+			}
+			if running == nil {
+				// Wait between servers (ensure port is fully released)
+				if started {
+					select {
+					case <-time.After(5 * time.Second):
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				started = true
+				srv := server.New(&srvCfg, cfg.Log)
+				if err := srv.Start(ctx); err != nil {
+					fmt.Fprintf(cfg.Log, "ERROR: %v\n", err)
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					return err
+				}
+				running = srv
+			}
+
+			result, err := runner.Run(ctx, srvCfg.Name, srvCfg.Port, running.GetPID())
+			if !s.reuse || err != nil {
+				stop()
+			}
+			if err != nil {
+				fmt.Fprintf(cfg.Log, "WARNING: Benchmark failed: %v\n", err)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return errors.New(result.Error)
+			}
+			values := serverMetrics(result)
+			rounds[v] = append(rounds[v], results.Round{Round: round + 1, Metrics: values})
+			prog.sample(srvCfg.Name, round+1, values)
+			return nil
+		},
+		done: func(v int, err error) {
+			stop()
+			variants[v] = serverVariant(s.servers[v].Name, rounds[v], err)
+			prog.finished(variants[v])
+		},
+	}.run(ctx, cfg.Options, len(s.servers), s.rounds)
+
+	// Print summary
+	printSummary(cfg.Log, variants)
+
+	cfg.Record(s.suiteResult(cfg, variants))
+	return ctx.Err()
+}
+
+// suiteResult records the variants of the servers
+func (s *serverSuite) suiteResult(cfg *suite.Config, variants []results.Variant) *results.Suite {
+	suiteResult := &results.Suite{
+		Suite:     "server",
+		Mode:      "load",
+		Runs:      s.rounds,
+		Params:    s.params(),
+		Timestamp: time.Now(),
+		Variants:  variants,
+	}
+	setOrder(suiteResult, cfg.Options)
+	return suiteResult
+}
+
+// params returns the load test parameters recorded with the results
+func (s *serverSuite) params() map[string]string {
+	return map[string]string{
+		"connections": strconv.Itoa(s.connections),
+		"pipeline":    strconv.Itoa(s.pipeline),
+		"duration":    strconv.Itoa(s.duration),
+	}
+}
+
+// serverVariant summarises the load tests of a server over its rounds and
+// keeps the metrics of every round. A server interrupted after some rounds
+// keeps them.
+func serverVariant(name string, rounds []results.Round, err error) results.Variant {
+	variant := results.Variant{Name: name, Status: results.StatusOK}
+	if err != nil {
+		variant = variantFailure(name, err)
+		if variant.Status != results.StatusInterrupted || len(rounds) == 0 {
+			return variant
+		}
+	}
+	var samples metrics.Samples
+	for _, r := range rounds {
+		samples.Add(r.Metrics)
+	}
+	variant.Metrics = samples.Summarize()
+	variant.Rounds = rounds
+	return variant
+}
+
+// serverMetrics returns the metrics of a load test: the request rate, the
+// latency estimated from it and the server's memory
+func serverMetrics(r *benchmark.Result) []metrics.Metric {
+	return []metrics.Metric{
+		{Name: "req_per_sec", Value: r.ReqPerSec, Unit: "req/s"},
+		{Name: "latency", Value: r.LatencyMs, Unit: "ms"},
+		{Name: "memory", Value: r.MemoryMB, Unit: "MB"},
+	}
+}
+
+// Cleanup does nothing: every server is stopped once it has been measured.
+func (s *serverSuite) Cleanup(ctx context.Context, cfg *suite.Config) error {
+	return nil
+}
+
+// printSummary prints the median request rate, latency and memory of every
+// server over its rounds, with the 95% confidence interval of the mean
+// request rate and its coefficient of variation
+func printSummary(w io.Writer, variants []results.Variant) {
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "BENCHMARK SUMMARY (median over rounds)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-16s %10s %8s %7s %10s %9s %12s\n", "Server", "Req/sec", "95% CI", "CV", "Latency", "Memory", "Status")
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+
+	for _, v := range variants {
+		reqPerSec, ci, cv, latency, memory := "N/A", "-", "-", "N/A", "N/A"
+		for _, m := range v.Metrics {
+			switch m.Name {
+			case "req_per_sec":
+				reqPerSec = fmt.Sprintf("%.0f", m.Median)
+				if m.Runs > 1 && m.Mean > 0 {
+					ci = fmt.Sprintf("±%.0f", m.CI95)
+					cv = fmt.Sprintf("%.1f%%", m.StdDev/m.Mean*100)
+				}
+			case "latency":
+				latency = fmt.Sprintf("%.2f ms", m.Median)
+			case "memory":
+				memory = fmt.Sprintf("%.1f MB", m.Median)
+			}
+		}
+		fmt.Fprintf(w, "%-16s %10s %8s %7s %10s %9s %12s\n", v.Name, reqPerSec, ci, cv, latency, memory, v.Status)
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintln(w, "95% CI: of the mean req/sec; CV: its stddev / mean; latency estimated from req/sec")
+}
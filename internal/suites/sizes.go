@@ -0,0 +1,106 @@
+package suites
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseSizes parses a comma-separated list of input sizes. Sizes may be
+// written in float notation, e.g. "1e3,1e4,1e5".
+func parseSizes(list string) ([]int, error) {
+	var sizes []int
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(field, 64)
+		if err != nil || f < 1 || f != float64(int(f)) {
+			return nil, fmt.Errorf("invalid size: %q", field)
+		}
+		sizes = append(sizes, int(f))
+	}
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("no sizes given")
+	}
+	return sizes, nil
+}
+
+// parseSizeSweep parses the --sizes of a suite whose benchmarks measure
+// their size in different units, e.g. kernels whose size is a tree depth or
+// a number of steps. A plain list ("1e3,1e4") sweeps the sizes of the one
+// selected benchmark; with several, the sizes are given per benchmark, each
+// name starting its list ("n-body=1e5,1e6,bubblesort=1e3"). Benchmarks
+// without sizes keep their default. what names a benchmark in errors, e.g.
+// "kernel".
+func parseSizeSweep(list, what string, selected []string) (map[string][]int, error) {
+	isSelected := make(map[string]bool)
+	for _, name := range selected {
+		isSelected[name] = true
+	}
+
+	fields := make(map[string][]string)
+	var order []string
+	current := ""
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if name, value, ok := strings.Cut(field, "="); ok {
+			current = strings.TrimSpace(name)
+			if !isSelected[current] {
+				return nil, fmt.Errorf("invalid sizes: %s %q is not selected (selected: %s)", what, current, strings.Join(selected, ", "))
+			}
+			if _, dup := fields[current]; dup {
+				return nil, fmt.Errorf("invalid sizes: %s %s given twice", what, current)
+			}
+			order = append(order, current)
+			field = value
+		} else if field == "" {
+			continue
+		} else if current == "" {
+			if len(selected) == 0 {
+				return nil, fmt.Errorf("invalid sizes: no %s selected", what)
+			}
+			if len(selected) != 1 {
+				return nil, fmt.Errorf("sizes mean something different for every %s: select one %s or give sizes per %s, e.g. %s=%s",
+					what, what, what, selected[0], strings.TrimSpace(list))
+			}
+			current = selected[0]
+			order = append(order, current)
+		}
+		fields[current] = append(fields[current], field)
+	}
+
+	sizes := make(map[string][]int)
+	for _, name := range order {
+		parsed, err := parseSizes(strings.Join(fields[name], ","))
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", what, name, err)
+		}
+		sizes[name] = parsed
+	}
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("no sizes given")
+	}
+	return sizes, nil
+}
+
+// withInputArgs returns a copy of langs whose programs are invoked with
+// the input size and seed as their first two arguments
+func withInputArgs(langs []program, size, seed int) []program {
+	return withArgs(langs, strconv.Itoa(size), strconv.Itoa(seed))
+}
+
+// withArgs returns a copy of langs whose programs are invoked with args
+func withArgs(langs []program, args ...string) []program {
+	suffix := " " + strings.Join(args, " ")
+	out := make([]program, len(langs))
+	for i, lang := range langs {
+		lang.runCmd += suffix
+		if lang.fullHotCmd != "" {
+			lang.fullHotCmd += suffix
+		}
+		out[i] = lang
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package suites
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSizes(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []int
+		wantErr bool
+	}{
+		{in: "1000", want: []int{1000}},
+		{in: "1e3,1e4,1e5", want: []int{1000, 10000, 100000}},
+		{in: " 10 , 2.5e1 ", want: []int{10, 25}},
+		{in: "1,,2,", want: []int{1, 2}},
+		{in: "1", want: []int{1}},
+		{in: "", wantErr: true},
+		{in: " , ", wantErr: true},
+		{in: "0", wantErr: true},
+		{in: "-5", wantErr: true},
+		{in: "1.5", wantErr: true},
+		{in: "1e-1", wantErr: true},
+		{in: "ten", wantErr: true},
+		{in: "10,x", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseSizes(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseSizes(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseSizes(%q): %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSizes(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseSizeSweep(t *testing.T) {
+	one := []string{"n-body"}
+	several := []string{"n-body", "bubblesort", "binary-trees"}
+
+	tests := []struct {
+		in       string
+		selected []string
+		want     map[string][]int
+		wantErr  string // substring of the error; empty for none
+	}{
+		{in: "1e5,1e6", selected: one, want: map[string][]int{"n-body": {100000, 1000000}}},
+		{in: "n-body=1e5", selected: one, want: map[string][]int{"n-body": {100000}}},
+		{
+			in:       "n-body=1e5,1e6,bubblesort=1e3",
+			selected: several,
+			want:     map[string][]int{"n-body": {100000, 1000000}, "bubblesort": {1000}},
+		},
+		{in: " bubblesort = 10 , 20 ", selected: several, want: map[string][]int{"bubblesort": {10, 20}}},
+		{in: "1e5", selected: several, wantErr: "select one kernel or give sizes per kernel, e.g. n-body=1e5"},
+		{in: "1e5", selected: nil, wantErr: "no kernel selected"},
+		{in: "fannkuch=10", selected: several, wantErr: `kernel "fannkuch" is not selected`},
+		{in: "n-body=1,bubblesort=2,n-body=3", selected: several, wantErr: "kernel n-body given twice"},
+		{in: "n-body=1,bubblesort=x", selected: several, wantErr: `kernel bubblesort: invalid size: "x"`},
+		{in: "n-body=", selected: several, wantErr: "kernel n-body: no sizes given"},
+		{in: "", selected: several, wantErr: "no sizes given"},
+	}
+	for _, tt := range tests {
+		got, err := parseSizeSweep(tt.in, "kernel", tt.selected)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseSizeSweep(%q, %v) = %v, %v; want error containing %q", tt.in, tt.selected, got, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseSizeSweep(%q, %v): %v", tt.in, tt.selected, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSizeSweep(%q, %v) = %v, want %v", tt.in, tt.selected, got, tt.want)
+		}
+	}
+}
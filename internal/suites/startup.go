@@ -0,0 +1,327 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/benchmarks/internal/workspace"
+	"github.com/spf13/pflag"
+)
+
+func init() {
+	suite.Register(suite.Registration{
+		Name:  "startup",
+		Short: "Run startup-time and idle-footprint benchmarks",
+		Long: `Launch a do-nothing program per language and runtime flag set and measure:
+  - time to main: from spawning the process to its main function
+  - time to first output: from spawning it to its first line reaching the runner
+  - CPU time: user and system time over the whole run (rusage)
+  - idle RSS: resident memory after the program has idled for --idle (/proc)
+  - peak RSS: peak resident memory up to that point (/proc)
+
+Variants cover runtime settings as well as languages: Go with GOGC variations,
+Java with CDS disabled, an AppCDS archive and different GCs, Node from a V8
+startup snapshot and Python without site. Programs run directly, without a
+shell, and are linux-only (/proc).`,
+		Flags: suite.RunFlags | suite.BuildFlags | suite.CompileTimeoutFlag | suite.RunTimeoutFlag | suite.OrderFlags,
+		New: func(baseDir string) suite.Suite {
+			return &startupSuite{baseDir: baseDir}
+		},
+	})
+}
+
+// startupSuite launches every variant repeatedly and measures its startup
+// and idle footprint
+type startupSuite struct {
+	baseDir string
+	idle    time.Duration // how long each program idles before its idle RSS is read
+
+	runDir string
+	langs  []program // built variants
+	result *results.Suite
+}
+
+func (s *startupSuite) Flags(fs *pflag.FlagSet) {
+	fs.DurationVar(&s.idle, "idle", 100*time.Millisecond, "How long programs idle before their idle RSS is read")
+}
+
+// getStartupLanguages returns the do-nothing programs, one variant per
+// language and runtime flag set. Run commands are executed directly, not
+// through sh, so they may only hold NAME=value words and arguments.
+// Variants whose build output depends on the runtime (CDS archives, V8
+// snapshots) have no binaryPath and are rebuilt every time.
+func getStartupLanguages(baseDir string) []program {
+	startupDir := filepath.Join(baseDir, "startup")
+	goLang := func(name, env string) program {
+		return program{
+			name:       name,
+			dir:        filepath.Join(startupDir, "go"),
+			compileCmd: "go build -ldflags=\"-s -w\" -o main main.go",
+			runCmd:     strings.TrimSpace(env + " ./main"),
+			binaryPath: "main",
+		}
+	}
+	javaLang := func(name, flags string) program {
+		return program{
+			name:       name,
+			dir:        filepath.Join(startupDir, "java"),
+			compileCmd: "javac Main.java",
+			runCmd:     "java " + strings.TrimSpace(flags+" Main"),
+			binaryPath: "Main.class",
+		}
+	}
+	return []program{
+		{
+			name:       "c",
+			dir:        filepath.Join(startupDir, "c"),
+			compileCmd: "gcc -O2 -o main main.c",
+			runCmd:     "./main",
+			binaryPath: "main",
+		},
+		goLang("go", ""),
+		goLang("go-gogc-off", "GOGC=off"),
+		goLang("go-gogc-20", "GOGC=20"),
+		{
+			name:       "rust",
+			dir:        filepath.Join(startupDir, "rust"),
+			compileCmd: "rustc -C opt-level=3 -o main main.rs",
+			runCmd:     "./main",
+			binaryPath: "main",
+		},
+		{
+			name:       "zig",
+			dir:        filepath.Join(startupDir, "zig"),
+			compileCmd: "zig build-exe -OReleaseFast -fstrip -femit-bin=main main.zig",
+			runCmd:     "./main",
+			binaryPath: "main",
+		},
+		javaLang("java", ""),
+		javaLang("java-xshare-off", "-Xshare:off"),
+		{
+			name:       "java-appcds",
+			dir:        filepath.Join(startupDir, "java"),
+			compileCmd: "javac Main.java && java -XX:ArchiveClassesAtExit=app.jsa Main < /dev/null",
+			runCmd:     "java -XX:SharedArchiveFile=app.jsa Main",
+			tags:       map[string]string{"toolchain": "javac"},
+		},
+		javaLang("java-serialgc", "-XX:+UseSerialGC"),
+		javaLang("java-zgc", "-XX:+UseZGC"),
+		{
+			name:   "nodejs",
+			dir:    filepath.Join(startupDir, "node"),
+			runCmd: "node main.js",
+		},
+		{
+			name:       "nodejs-snapshot",
+			dir:        filepath.Join(startupDir, "node"),
+			compileCmd: "node --snapshot-blob snapshot.blob --build-snapshot snapshot.js",
+			runCmd:     "node --snapshot-blob snapshot.blob",
+		},
+		{
+			name:   "python",
+			dir:    filepath.Join(startupDir, "python"),
+			runCmd: "python3 main.py",
+		},
+		{
+			name:   "python-nosite",
+			dir:    filepath.Join(startupDir, "python"),
+			runCmd: "python3 -S main.py",
+		},
+	}
+}
+
+func (s *startupSuite) Variants() []suite.Variant {
+	return variantNames(getStartupLanguages(s.baseDir))
+}
+
+// Prepare copies the selected variants into workspaces and builds them.
+func (s *startupSuite) Prepare(ctx context.Context, cfg *suite.Config) error {
+	s.langs = selectPrograms(cfg, getStartupLanguages(s.baseDir))
+
+	runDir, err := workspace.MkdirTemp("startup")
+	if err != nil {
+		return fmt.Errorf("failed to create build directory: %w", err)
+	}
+	s.runDir = runDir
+
+	workspaces := make(map[string]*workspace.Workspace)
+	for i, lang := range s.langs {
+		ws, err := workspace.New(runDir, lang.name, lang.dir, lang.shared)
+		if err != nil {
+			return err
+		}
+		workspaces[lang.name] = ws
+		s.langs[i].dir = ws.Dir
+	}
+
+	fmt.Fprintf(cfg.Log, "Running startup benchmarks (%d warmup, %d runs, %s idle, order %s)\n", cfg.Warmup, cfg.Runs, s.idle, cfg.DescribeOrder())
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	s.result = &results.Suite{
+		Suite:     "startup",
+		Mode:      "exec",
+		Warmup:    cfg.Warmup,
+		Runs:      cfg.Runs,
+		Params:    map[string]string{"idle": s.idle.String()},
+		Timestamp: time.Now(),
+	}
+	setOrder(s.result, cfg.Options)
+
+	cache, err := openBuildCache(cfg.Options)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cfg.Log, "Pre-compiling binaries (%d jobs)...\n", cfg.Jobs)
+	buildErrs := precompile(ctx, cfg.Log, s.langs, workspaces, cache, cfg.Jobs)
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	var built []program
+	for _, lang := range s.langs {
+		if err, ok := buildErrs[lang.name]; ok {
+			variant := variantFailure(lang.name, err)
+			s.progress(cfg).finished(variant)
+			s.result.Variants = append(s.result.Variants, variant)
+			continue
+		}
+		built = append(built, lang)
+	}
+	s.langs = built
+	return nil
+}
+
+// Plan lists the build of every selected variant and the launches
+// measure makes.
+func (s *startupSuite) Plan(cfg *suite.Config) ([]suite.PlanBenchmark, error) {
+	all := getStartupLanguages(s.baseDir)
+	b := suite.PlanBenchmark{
+		Name:    "startup",
+		Params:  map[string]string{"idle": s.idle.String()},
+		Mode:    "exec",
+		Tool:    "startup",
+		Order:   cfg.DescribeOrder(),
+		Skipped: unselected(cfg, all),
+	}
+	b.Variants = planVariants("startup", selectPrograms(cfg, all), func(lang program) []suite.PlanStep {
+		var steps []suite.PlanStep
+		if lang.compileCmd != "" {
+			steps = append(steps, precompileStep(cfg.Options, lang))
+		}
+		action := fmt.Sprintf("launch without a shell, idle %s (measured after %d warmup)", s.idle, cfg.Warmup)
+		return append(steps, planStep(suite.PhaseRun, action, lang.runCmd, lang.runTimeout(), cfg.Warmup+cfg.Runs))
+	})
+	return []suite.PlanBenchmark{b}, nil
+}
+
+// Verify does nothing: the measurement itself checks that every program
+// follows the startup protocol.
+func (s *startupSuite) Verify(ctx context.Context, cfg *suite.Config) error {
+	return nil
+}
+
+func (s *startupSuite) Run(ctx context.Context, cfg *suite.Config) error {
+	prog := s.progress(cfg)
+	variants := make([]results.Variant, len(s.langs))
+	samples := make([]metrics.Samples, len(s.langs))
+
+	iterations{
+		start: func(v int) { prog.started(s.langs[v].name) },
+		iterate: func(v, i int) error {
+			values, err := s.measure(ctx, s.langs[v])
+			if err != nil || i < cfg.Warmup {
+				return err
+			}
+			samples[v].Add(values)
+			prog.sample(s.langs[v].name, i-cfg.Warmup+1, values)
+			return nil
+		},
+		done: func(v int, err error) {
+			lang := s.langs[v]
+			variants[v] = results.Variant{Name: lang.name, Status: results.StatusOK, Metrics: samples[v].Summarize()}
+			if err != nil {
+				variants[v] = variantFailure(lang.name, err)
+				if variants[v].Status != results.StatusInterrupted {
+					fmt.Fprintf(cfg.Log, "%-20s: %s\n%v\n", lang.name, variants[v].Status, err)
+				}
+			} else {
+				fmt.Fprintf(cfg.Log, "%-20s: OK\n", lang.name)
+			}
+			prog.finished(variants[v])
+		},
+	}.run(ctx, cfg.Options, len(s.langs), cfg.Warmup+cfg.Runs)
+	s.result.Variants = append(s.result.Variants, variants...)
+
+	printStartupSummary(cfg.Log, s.result.Variants)
+	cfg.Record(s.result)
+	return ctx.Err()
+}
+
+func (s *startupSuite) Cleanup(ctx context.Context, cfg *suite.Config) error {
+	if s.runDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(s.runDir)
+	s.runDir = ""
+	return err
+}
+
+// progress reports the startup variants to cfg
+func (s *startupSuite) progress(cfg *suite.Config) progress {
+	return progress{cfg: cfg, benchmark: "startup", params: s.result.Params}
+}
+
+// measure launches lang once and returns its startup times, CPU time and
+// memory
+func (s *startupSuite) measure(ctx context.Context, lang program) ([]metrics.Metric, error) {
+	sample, err := measure.Startup(ctx, lang.dir, strings.Fields(lang.runCmd), s.idle, lang.runTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return []metrics.Metric{
+		{Name: "time_to_main", Value: durationMs(sample.TimeToMain), Unit: "ms"},
+		{Name: "time_to_first_output", Value: durationMs(sample.TimeToFirstOutput), Unit: "ms"},
+		{Name: "idle_rss", Value: float64(sample.IdleRSS) / 1024, Unit: "MiB"},
+		{Name: "peak_rss", Value: float64(sample.PeakRSS) / 1024, Unit: "MiB"},
+		{Name: "cpu_time", Value: durationMs(sample.CPUTime), Unit: "ms"},
+	}, nil
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d.Nanoseconds()) / 1e6
+}
+
+// printStartupSummary prints every variant's startup times, CPU time and
+// memory side by side
+func printStartupSummary(w io.Writer, variants []results.Variant) {
+	fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+	fmt.Fprintln(w, "STARTUP (mean ± stddev)")
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+	fmt.Fprintf(w, "%-15s %16s %16s %9s %9s %9s\n", "Language", "To main", "To first output", "CPU time", "Idle RSS", "Peak RSS")
+	fmt.Fprintln(w, strings.Repeat("-", 80))
+	for _, v := range variants {
+		if v.Status != results.StatusOK {
+			fmt.Fprintf(w, "%-15s %16s\n", v.Name, v.Status)
+			continue
+		}
+		cells := make(map[string]string)
+		for _, m := range v.Metrics {
+			switch m.Name {
+			case "time_to_main", "time_to_first_output":
+				cells[m.Name] = fmt.Sprintf("%.2f ± %.2f ms", m.Mean, m.StdDev)
+			default:
+				cells[m.Name] = fmt.Sprintf("%.1f %s", m.Mean, m.Unit)
+			}
+		}
+		fmt.Fprintf(w, "%-15s %16s %16s %9s %9s %9s\n", v.Name, cells["time_to_main"],
+			cells["time_to_first_output"], cells["cpu_time"], cells["idle_rss"], cells["peak_rss"])
+	}
+	fmt.Fprintln(w, strings.Repeat("=", 80))
+}
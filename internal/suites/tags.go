@@ -0,0 +1,119 @@
+package suites
+
+import (
+	"strings"
+
+	"github.com/benchmarks/internal/suite"
+)
+
+// Tags of the program variants, derived from their names and commands:
+//
+//	lang       c, cpp, go, rust, zig, java, javascript, typescript, python
+//	kind       compiled; for programs run by node or python interpreted,
+//	           bundled (by esbuild) or snapshot (a V8 startup snapshot)
+//	build      the build tool: cmake, cargo, zig (zig build), esbuild or
+//	           snapshot; direct for plain compiler invocations and none
+//	           for programs without a build
+//	toolchain  the compiler, or the interpreter of interpreted programs
+//	runtime    native, jvm, node or python
+//
+// A program's tags field overrides the derived values.
+var (
+	// langTags maps the first part of variant names to their language
+	langTags = map[string]string{
+		"node":   "javascript",
+		"nodejs": "javascript",
+		"nodets": "typescript",
+	}
+	// runtimeTags maps the first word of run commands to their runtime
+	runtimeTags = map[string]string{
+		"node":    "node",
+		"python3": "python",
+		"java":    "jvm",
+	}
+	// buildSuffixes are the variant name suffixes that name the build
+	buildSuffixes = map[string]bool{"direct": true, "cmake": true, "cargo": true}
+	// bundleKinds are the kinds of interpreted programs built by a tool
+	bundleKinds = map[string]string{"esbuild": "bundled", "snapshot": "snapshot"}
+)
+
+// variant returns the variant of the program, with its tags
+func (p program) variant() suite.Variant {
+	first, _, _ := strings.Cut(p.name, "-")
+	suffix := ""
+	if i := strings.LastIndex(p.name, "-"); i >= 0 {
+		suffix = p.name[i+1:]
+	}
+
+	tags := map[string]string{"lang": first, "runtime": "native", "kind": "compiled"}
+	if lang, ok := langTags[first]; ok {
+		tags["lang"] = lang
+	}
+	runTool := commandTool(p.runCmd)
+	if runtime, ok := runtimeTags[runTool]; ok {
+		tags["runtime"] = runtime
+	}
+
+	tool := buildTool(p.compileCmd)
+	switch tags["runtime"] {
+	case "node", "python":
+		tags["kind"] = "interpreted"
+		if kind, ok := bundleKinds[tool]; ok {
+			tags["kind"] = kind
+		}
+		tags["toolchain"] = runTool
+	default:
+		steps := strings.Split(p.compileCmd, "&&")
+		tags["toolchain"] = commandTool(steps[len(steps)-1])
+	}
+
+	switch {
+	case tool != "":
+		tags["build"] = tool
+	case buildSuffixes[suffix]:
+		tags["build"] = suffix
+	case p.compileCmd == "":
+		tags["build"] = "none"
+	default:
+		tags["build"] = "direct"
+	}
+
+	for key, value := range p.tags {
+		tags[key] = value
+	}
+	return suite.Variant{Name: p.name, Tags: tags}
+}
+
+// buildTool returns the build tool a compile command runs: cargo, cmake,
+// zig (zig build), esbuild or snapshot (node --build-snapshot), or "" for
+// plain compiler invocations
+func buildTool(compileCmd string) string {
+	for _, step := range strings.Split(compileCmd, "&&") {
+		words := strings.Fields(step)
+		tool := commandTool(step)
+		if tool == "npx" && len(words) > 1 {
+			tool = words[1]
+		}
+		switch {
+		case tool == "cargo" || tool == "cmake" || tool == "esbuild":
+			return tool
+		case tool == "zig" && len(words) > 1 && words[1] == "build":
+			return "zig"
+		case tool == "node" && strings.Contains(step, "--build-snapshot"):
+			return "snapshot"
+		}
+	}
+	return ""
+}
+
+// commandTool returns the program a shell command line starts, without the
+// variable assignments before it
+func commandTool(cmdLine string) string {
+	for _, word := range strings.Fields(cmdLine) {
+		if strings.Contains(word, "=") {
+			continue
+		}
+		return word
+	}
+	return ""
+}
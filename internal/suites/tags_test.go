@@ -0,0 +1,76 @@
+package suites
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVariantTags(t *testing.T) {
+	tests := []struct {
+		prog program
+		want map[string]string
+	}{
+		{
+			prog: program{name: "rust-cargo", compileCmd: "cargo build --release", runCmd: "./target/release/hello"},
+			want: map[string]string{"lang": "rust", "kind": "compiled", "build": "cargo", "toolchain": "cargo", "runtime": "native"},
+		},
+		{
+			prog: program{name: "cpp", compileCmd: "cmake -B build && cmake --build build", runCmd: "./build/main"},
+			want: map[string]string{"lang": "cpp", "kind": "compiled", "build": "cmake", "toolchain": "cmake", "runtime": "native"},
+		},
+		{
+			prog: program{name: "c-direct", compileCmd: "gcc -O2 -o hello main.c", runCmd: "./hello"},
+			want: map[string]string{"lang": "c", "kind": "compiled", "build": "direct", "toolchain": "gcc", "runtime": "native"},
+		},
+		{
+			prog: program{name: "zig-build", compileCmd: "zig build -Doptimize=ReleaseFast", runCmd: "./zig-out/bin/hello"},
+			want: map[string]string{"lang": "zig", "kind": "compiled", "build": "zig", "toolchain": "zig", "runtime": "native"},
+		},
+		{
+			prog: program{name: "zig-direct", compileCmd: "zig build-exe -OReleaseFast main.zig", runCmd: "./hello"},
+			want: map[string]string{"lang": "zig", "kind": "compiled", "build": "direct", "toolchain": "zig", "runtime": "native"},
+		},
+		{
+			prog: program{name: "go-gogc-off", compileCmd: "go build -o main", runCmd: "GOGC=off ./main"},
+			want: map[string]string{"lang": "go", "kind": "compiled", "build": "direct", "toolchain": "go", "runtime": "native"},
+		},
+		{
+			prog: program{name: "java", compileCmd: "javac Main.java", runCmd: "java Main"},
+			want: map[string]string{"lang": "java", "kind": "compiled", "build": "direct", "toolchain": "javac", "runtime": "jvm"},
+		},
+		{
+			prog: program{name: "nodejs-direct", runCmd: "node main.js"},
+			want: map[string]string{"lang": "javascript", "kind": "interpreted", "build": "direct", "toolchain": "node", "runtime": "node"},
+		},
+		{
+			prog: program{name: "nodejs-build", compileCmd: "npx esbuild main.js --bundle --outfile=main.min.js", runCmd: "node main.min.js"},
+			want: map[string]string{"lang": "javascript", "kind": "bundled", "build": "esbuild", "toolchain": "node", "runtime": "node"},
+		},
+		{
+			prog: program{name: "nodets-build", compileCmd: "npx tsc --noEmit && npx esbuild main.ts --bundle --outfile=main.min.js", runCmd: "node main.min.js"},
+			want: map[string]string{"lang": "typescript", "kind": "bundled", "build": "esbuild", "toolchain": "node", "runtime": "node"},
+		},
+		{
+			prog: program{name: "nodets-direct", compileCmd: "npx tsc", runCmd: "node dist/main.js"},
+			want: map[string]string{"lang": "typescript", "kind": "interpreted", "build": "direct", "toolchain": "node", "runtime": "node"},
+		},
+		{
+			prog: program{name: "nodejs-snapshot", compileCmd: "node --snapshot-blob snapshot.blob --build-snapshot snapshot.js", runCmd: "node --snapshot-blob snapshot.blob"},
+			want: map[string]string{"lang": "javascript", "kind": "snapshot", "build": "snapshot", "toolchain": "node", "runtime": "node"},
+		},
+		{
+			prog: program{name: "python", runCmd: "python3 main.py"},
+			want: map[string]string{"lang": "python", "kind": "interpreted", "build": "none", "toolchain": "python3", "runtime": "python"},
+		},
+		// a program's tags override the derived ones
+		{
+			prog: program{name: "java-appcds", compileCmd: "javac Main.java && java -XX:ArchiveClassesAtExit=app.jsa Main", runCmd: "java -XX:SharedArchiveFile=app.jsa Main", tags: map[string]string{"toolchain": "javac"}},
+			want: map[string]string{"lang": "java", "kind": "compiled", "build": "direct", "toolchain": "javac", "runtime": "jvm"},
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.prog.variant().Tags; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s tags = %v, want %v", tt.prog.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package suites
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+)
+
+// stepTimeouts overrides the suite's step timeouts for a variant. Zero
+// values fall back to the --compile-timeout, --prepare-timeout and
+// --run-timeout flags when the variant is selected.
+type stepTimeouts struct {
+	compile time.Duration
+	prepare time.Duration
+	run     time.Duration
+}
+
+// withDefaults returns t with its zero values set from opts
+func (t stepTimeouts) withDefaults(opts suite.Options) stepTimeouts {
+	if t.compile == 0 {
+		t.compile = opts.CompileTimeout
+	}
+	if t.prepare == 0 {
+		t.prepare = opts.PrepareTimeout
+	}
+	if t.run == 0 {
+		t.run = opts.RunTimeout
+	}
+	return t
+}
+
+func (l program) compileTimeout() time.Duration { return l.timeouts.compile }
+
+func (l program) prepareTimeout() time.Duration { return l.timeouts.prepare }
+
+func (l program) runTimeout() time.Duration { return l.timeouts.run }
+
+// probeVariant runs one iteration of the command the benchmark tool will
+// measure, under the step timeouts. Variants that fail or hang here are
+// left out of the tool run, which has no per-command timeout of its own.
+func probeVariant(ctx context.Context, mode string, lang program) error {
+	switch mode {
+	case "compile":
+		if lang.cleanCmd != "" {
+			if _, err := measure.Shell(ctx, lang.dir, lang.cleanCmd, lang.prepareTimeout()); err != nil {
+				return err
+			}
+		}
+		_, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout())
+		return err
+	default:
+		_, err := measure.Shell(ctx, lang.dir, lang.runCmd, lang.runTimeout())
+		return err
+	}
+}
+
+// toolDeadline bounds a whole poop/hyperfine invocation: every iteration
+// of every variant (plus hyperfine's initial prepare) at its step timeouts.
+func toolDeadline(opts suite.Options, langs []program) time.Duration {
+	var total time.Duration
+	for _, lang := range langs {
+		step := lang.runTimeout()
+		if opts.Mode == "compile" {
+			step = lang.prepareTimeout() + lang.compileTimeout()
+		}
+		total += time.Duration(opts.Warmup+opts.Runs+1) * step
+	}
+	return total
+}
+
+// variantFailure records a variant that failed, timed out or was
+// interrupted
+func variantFailure(name string, err error) results.Variant {
+	status := results.StatusFailed
+	if errors.Is(err, measure.ErrTimeout) {
+		status = results.StatusTimeout
+	} else if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		status = results.StatusInterrupted
+	}
+	return results.Variant{Name: name, Status: status, Error: err.Error()}
+}
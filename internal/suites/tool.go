@@ -0,0 +1,185 @@
+package suites
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/suite"
+)
+
+// joinCmds chains the non-empty shell commands with &&
+func joinCmds(cmds ...string) string {
+	var parts []string
+	for _, c := range cmds {
+		if c != "" {
+			parts = append(parts, c)
+		}
+	}
+	return strings.Join(parts, " && ")
+}
+
+// runBenchTool benchmarks the compile or exec commands of langs with poop
+// or hyperfine
+func runBenchTool(ctx context.Context, cfg *suite.Config, benchTool string, langs []program) ([]*measure.Stats, error) {
+	fmt.Fprintf(cfg.Log, "\nRunning benchmarks with %s...\n", benchTool)
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	// hyperfine exports its statistics so they can be stored with the results
+	exportFile, err := os.CreateTemp("", "benchrunner-hyperfine-*.json")
+	if err != nil {
+		return nil, err
+	}
+	exportPath := exportFile.Name()
+	exportFile.Close()
+	defer os.Remove(exportPath)
+
+	cmdArgs := benchToolArgs(cfg.Options, benchTool, langs, exportPath)
+
+	// Run benchmark tool in its own process group so a hung program can be
+	// killed together with the tool
+	benchExec := exec.Command(benchTool, cmdArgs...)
+	benchExec.Stdout = cfg.Log
+	benchExec.Stderr = os.Stderr
+	benchExec.Dir = cfg.BaseDir
+	benchExec.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := benchExec.Start(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w", benchTool, err)
+	}
+	deadline := toolDeadline(cfg.Options, langs)
+	if err := measure.WaitTimeout(ctx, benchExec, deadline); err != nil {
+		if errors.Is(err, measure.ErrTimeout) {
+			return nil, fmt.Errorf("%s %w after %s", benchTool, err, deadline)
+		}
+		return nil, fmt.Errorf("%s failed: %w", benchTool, err)
+	}
+
+	fmt.Fprintln(cfg.Log, strings.Repeat("=", 80))
+
+	if benchTool != "hyperfine" {
+		return nil, nil
+	}
+	stats, err := readHyperfineExport(exportPath)
+	if err != nil {
+		fmt.Fprintf(cfg.Log, "WARNING: failed to read hyperfine results: %v\n", err)
+		return nil, nil
+	}
+	if len(stats) != len(langs) {
+		fmt.Fprintf(cfg.Log, "WARNING: hyperfine reported %d results for %d commands\n", len(stats), len(langs))
+		return nil, nil
+	}
+	return stats, nil
+}
+
+// benchToolArgs returns the arguments of the poop or hyperfine invocation
+// timing the compile or exec commands of langs. hyperfine exports its
+// statistics to exportPath.
+func benchToolArgs(opts suite.Options, benchTool string, langs []program, exportPath string) []string {
+	var cmdArgs []string
+	if benchTool == "poop" {
+		for _, lang := range langs {
+			var benchCmd string
+			switch opts.Mode {
+			case "compile":
+				benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, joinCmds(lang.cleanCmd, lang.compileCmd))
+			case "exec":
+				benchCmd = fmt.Sprintf("cd %s && %s", lang.dir, lang.runCmd)
+			}
+			cmdArgs = append(cmdArgs, benchCmd)
+		}
+	} else {
+		cmdArgs = append(cmdArgs, "--warmup", fmt.Sprintf("%d", opts.Warmup), "--runs", fmt.Sprintf("%d", opts.Runs))
+		cmdArgs = append(cmdArgs, "--export-json", exportPath)
+
+		// Collect all benchmark commands with their prepare commands
+		type benchEntry struct {
+			name       string
+			dir        string
+			benchCmd   string
+			prepareCmd string
+		}
+		var entries []benchEntry
+
+		for _, lang := range langs {
+			var benchCmd, prepareCmd string
+
+			switch opts.Mode {
+			case "compile":
+				benchCmd = lang.compileCmd
+				prepareCmd = lang.cleanCmd
+
+			case "exec":
+				benchCmd = lang.runCmd
+			}
+
+			entries = append(entries, benchEntry{name: lang.name, dir: lang.dir, benchCmd: benchCmd, prepareCmd: prepareCmd})
+		}
+
+		// Check if any entry needs a prepare command
+		needsPrepare := false
+		for _, e := range entries {
+			if e.prepareCmd != "" {
+				needsPrepare = true
+				break
+			}
+		}
+
+		// Add commands with prepare if needed (hyperfine requires 0, 1, or N prepare commands)
+		for _, e := range entries {
+			if needsPrepare {
+				prepare := e.prepareCmd
+				if prepare == "" {
+					prepare = "true" // no-op for languages that don't need preparation
+				} else {
+					// Wrap prepare with cd to the directory
+					prepare = fmt.Sprintf("cd %s && %s", e.dir, prepare)
+				}
+				cmdArgs = append(cmdArgs, "--prepare", prepare)
+			}
+			// Wrap benchmark command with cd to the directory, show just name: cmd in display
+			fullCmd := fmt.Sprintf("cd %s && %s", e.dir, e.benchCmd)
+			cmdArgs = append(cmdArgs, "--command-name", fmt.Sprintf("%s: %s", e.name, e.benchCmd), fullCmd)
+		}
+	}
+	return cmdArgs
+}
+
+// readHyperfineExport reads the statistics of every command from a
+// hyperfine --export-json file
+func readHyperfineExport(path string) ([]*measure.Stats, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var export struct {
+		Results []struct {
+			Mean   float64 `json:"mean"`
+			StdDev float64 `json:"stddev"`
+			Median float64 `json:"median"`
+			Min    float64 `json:"min"`
+			Max    float64 `json:"max"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, err
+	}
+	var stats []*measure.Stats
+	for _, r := range export.Results {
+		// hyperfine reports seconds
+		stats = append(stats, &measure.Stats{
+			Mean:   r.Mean * 1000,
+			StdDev: r.StdDev * 1000,
+			Median: r.Median * 1000,
+			Min:    r.Min * 1000,
+			Max:    r.Max * 1000,
+		})
+	}
+	return stats, nil
+}
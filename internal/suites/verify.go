@@ -0,0 +1,98 @@
+package suites
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+)
+
+// hasReference reports whether one of langs is a reference implementation
+func hasReference(langs []program) bool {
+	for _, lang := range langs {
+		if lang.reference {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOutputs runs every variant once and compares its standard output
+// with the reference variant's. It returns the error of every variant that
+// failed, timed out or printed a different result, and whether the outputs
+// were checked at all (they aren't when the reference isn't selected).
+func checkOutputs(ctx context.Context, w io.Writer, mode string, langs []program) (map[string]error, bool) {
+	errs := make(map[string]error)
+
+	var ref *program
+	for i := range langs {
+		if langs[i].reference {
+			ref = &langs[i]
+		}
+	}
+	if ref == nil {
+		fmt.Fprintln(w, "Output not checked (reference variant not selected)")
+		return errs, false
+	}
+
+	want, err := variantOutput(ctx, mode, *ref)
+	if err != nil {
+		fmt.Fprintf(w, "%-20s: %s\n%v\n", ref.name, variantFailure(ref.name, err).Status, err)
+		errs[ref.name] = err
+		fmt.Fprintln(w, "Output not checked (reference variant failed)")
+		return errs, false
+	}
+	fmt.Fprintf(w, "%-20s: OK (reference)\n", ref.name)
+
+	for _, lang := range langs {
+		if lang.name == ref.name {
+			continue
+		}
+		got, err := variantOutput(ctx, mode, lang)
+		if err == nil && got != want {
+			err = fmt.Errorf("output differs from %s: %s", ref.name, firstDiff(got, want))
+		}
+		if err != nil {
+			fmt.Fprintf(w, "%-20s: %s\n%v\n", lang.name, variantFailure(lang.name, err).Status, err)
+			errs[lang.name] = err
+			continue
+		}
+		fmt.Fprintf(w, "%-20s: OK\n", lang.name)
+	}
+	return errs, true
+}
+
+// variantOutput builds lang if the mode hasn't done so already and returns
+// the standard output of one run, without metric lines (which vary from
+// run to run)
+func variantOutput(ctx context.Context, mode string, lang program) (string, error) {
+	if mode != "exec" && lang.compileCmd != "" {
+		if _, err := measure.Shell(ctx, lang.dir, lang.compileCmd, lang.compileTimeout()); err != nil {
+			return "", err
+		}
+	}
+	output, err := measure.Output(ctx, lang.dir, lang.runCmd, lang.runTimeout())
+	return metrics.Strip(output), err
+}
+
+// firstDiff describes the first line where got and want differ
+func firstDiff(got, want string) string {
+	gotLines := strings.Split(got, "\n")
+	wantLines := strings.Split(want, "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var g, w string
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			return fmt.Sprintf("line %d: got %q, want %q", i+1, g, w)
+		}
+	}
+	return "outputs differ"
+}
@@ -0,0 +1,108 @@
+package workspace
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Cache is a compiler or package manager cache that is redirected to a
+// fresh directory for cold builds.
+type Cache struct {
+	Env  string // environment variable selecting the cache location
+	Path string // directory the variable points to
+}
+
+// cacheSpec maps a build tool to the environment variables controlling its
+// caches. Paths are created under the workspace root unless inDir is set,
+// in which case they are relative to the workspace directory (used when the
+// run command expects the output at the tool's default location).
+type cacheSpec struct {
+	env   string
+	path  string
+	inDir bool
+}
+
+// Only caches of build output are listed. Downloaded dependencies (the Go
+// module cache, CARGO_HOME with the cargo registry) stay shared so cold
+// builds work offline; they hold sources, not compiled code. Cargo keeps
+// everything it compiles in the target directory, so emptying that is what
+// makes a cargo build cold.
+var cacheSpecs = map[string][]cacheSpec{
+	"go":    {{env: "GOCACHE", path: "go-build"}},
+	"cargo": {{env: "CARGO_TARGET_DIR", path: "target", inDir: true}},
+	"zig": {
+		{env: "ZIG_GLOBAL_CACHE_DIR", path: "zig-global"},
+		{env: "ZIG_LOCAL_CACHE_DIR", path: "zig-local"},
+	},
+	"npx":   {{env: "npm_config_cache", path: "npm"}},
+	"gcc":   {{env: "CCACHE_DIR", path: "ccache"}},
+	"g++":   {{env: "CCACHE_DIR", path: "ccache"}},
+	"cmake": {{env: "CCACHE_DIR", path: "ccache"}},
+}
+
+// ColdCaches returns the caches used by the tools in compileCmd, pointed at
+// directories inside the workspace. Each tool's caches are listed once.
+func (w *Workspace) ColdCaches(compileCmd string) []Cache {
+	var caches []Cache
+	seen := make(map[string]bool)
+	for _, tool := range Tools(compileCmd) {
+		for _, spec := range cacheSpecs[tool] {
+			if seen[spec.env] {
+				continue
+			}
+			seen[spec.env] = true
+			path := filepath.Join(w.Root, "cache", spec.path)
+			if spec.inDir {
+				path = w.Path(spec.path)
+			}
+			caches = append(caches, Cache{Env: spec.env, Path: path})
+		}
+	}
+	return caches
+}
+
+// ExportCmd returns a shell command exporting the cache variables, or an
+// empty string if there are none.
+func ExportCmd(caches []Cache) string {
+	if len(caches) == 0 {
+		return ""
+	}
+	var assigns []string
+	for _, c := range caches {
+		assigns = append(assigns, fmt.Sprintf("%s=%s", c.Env, ShellQuote(c.Path)))
+	}
+	return "export " + strings.Join(assigns, " ")
+}
+
+// ResetCmd returns a shell command that empties every cache directory, or
+// an empty string if there are none.
+func ResetCmd(caches []Cache) string {
+	if len(caches) == 0 {
+		return ""
+	}
+	var paths []string
+	for _, c := range caches {
+		paths = append(paths, ShellQuote(c.Path))
+	}
+	joined := strings.Join(paths, " ")
+	return fmt.Sprintf("rm -rf %s && mkdir -p %s", joined, joined)
+}
+
+// ShellQuote quotes s for use as a single POSIX shell word.
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Tools returns the program name of every simple command in a shell
+// command line such as "npx tsc --noEmit && npx esbuild main.ts".
+func Tools(cmdLine string) []string {
+	var tools []string
+	for _, part := range strings.FieldsFunc(cmdLine, func(r rune) bool { return r == '&' || r == ';' || r == '|' }) {
+		fields := strings.Fields(part)
+		if len(fields) > 0 {
+			tools = append(tools, fields[0])
+		}
+	}
+	return tools
+}
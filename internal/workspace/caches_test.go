@@ -0,0 +1,82 @@
+package workspace
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestColdCaches(t *testing.T) {
+	w := &Workspace{Root: "/ws/rust", Dir: "/ws/rust/src"}
+	tests := []struct {
+		compileCmd string
+		want       []Cache
+	}{
+		{compileCmd: "", want: nil},
+		{compileCmd: "javac Main.java", want: nil},
+		{compileCmd: "go build -o main", want: []Cache{{Env: "GOCACHE", Path: "/ws/rust/cache/go-build"}}},
+		// cargo writes its target directory where the run command expects it
+		{compileCmd: "cargo build --release", want: []Cache{{Env: "CARGO_TARGET_DIR", Path: "/ws/rust/src/target"}}},
+		{compileCmd: "zig build -Doptimize=ReleaseFast", want: []Cache{
+			{Env: "ZIG_GLOBAL_CACHE_DIR", Path: "/ws/rust/cache/zig-global"},
+			{Env: "ZIG_LOCAL_CACHE_DIR", Path: "/ws/rust/cache/zig-local"},
+		}},
+		// every tool of the command line, each cache once
+		{compileCmd: "npx tsc --noEmit && npx esbuild main.ts", want: []Cache{{Env: "npm_config_cache", Path: "/ws/rust/cache/npm"}}},
+		{compileCmd: "gcc -c lib.c; g++ main.cpp lib.o", want: []Cache{{Env: "CCACHE_DIR", Path: "/ws/rust/cache/ccache"}}},
+		{compileCmd: "cmake -B build && cmake --build build | tee log", want: []Cache{{Env: "CCACHE_DIR", Path: "/ws/rust/cache/ccache"}}},
+		{compileCmd: "go build -o main && gcc -o helper helper.c", want: []Cache{
+			{Env: "GOCACHE", Path: "/ws/rust/cache/go-build"},
+			{Env: "CCACHE_DIR", Path: "/ws/rust/cache/ccache"},
+		}},
+	}
+	for _, tt := range tests {
+		if got := w.ColdCaches(tt.compileCmd); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ColdCaches(%q) = %+v, want %+v", tt.compileCmd, got, tt.want)
+		}
+	}
+}
+
+func TestCacheCmds(t *testing.T) {
+	tests := []struct {
+		caches     []Cache
+		wantExport string
+		wantReset  string
+	}{
+		{caches: nil, wantExport: "", wantReset: ""},
+		{
+			caches:     []Cache{{Env: "GOCACHE", Path: "/ws/go/cache/go-build"}},
+			wantExport: "export GOCACHE='/ws/go/cache/go-build'",
+			wantReset:  "rm -rf '/ws/go/cache/go-build' && mkdir -p '/ws/go/cache/go-build'",
+		},
+		{
+			caches:     []Cache{{Env: "ZIG_GLOBAL_CACHE_DIR", Path: "/ws/a b/g"}, {Env: "ZIG_LOCAL_CACHE_DIR", Path: "/ws/it's/l"}},
+			wantExport: `export ZIG_GLOBAL_CACHE_DIR='/ws/a b/g' ZIG_LOCAL_CACHE_DIR='/ws/it'\''s/l'`,
+			wantReset:  `rm -rf '/ws/a b/g' '/ws/it'\''s/l' && mkdir -p '/ws/a b/g' '/ws/it'\''s/l'`,
+		},
+	}
+	for _, tt := range tests {
+		if got := ExportCmd(tt.caches); got != tt.wantExport {
+			t.Errorf("ExportCmd(%+v) = %q, want %q", tt.caches, got, tt.wantExport)
+		}
+		if got := ResetCmd(tt.caches); got != tt.wantReset {
+			t.Errorf("ResetCmd(%+v) = %q, want %q", tt.caches, got, tt.wantReset)
+		}
+	}
+}
+
+func TestTools(t *testing.T) {
+	tests := []struct {
+		cmdLine string
+		want    []string
+	}{
+		{cmdLine: "", want: nil},
+		{cmdLine: "go build", want: []string{"go"}},
+		{cmdLine: "npx tsc --noEmit && npx esbuild main.ts", want: []string{"npx", "npx"}},
+		{cmdLine: "gcc -c a.c; g++ b.cpp || cc c.c | tee log", want: []string{"gcc", "g++", "cc", "tee"}},
+	}
+	for _, tt := range tests {
+		if got := Tools(tt.cmdLine); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tools(%q) = %q, want %q", tt.cmdLine, got, tt.want)
+		}
+	}
+}
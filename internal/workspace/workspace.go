@@ -0,0 +1,184 @@
+package workspace
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// skipDirs are build output directories that are never copied into a
+// workspace, so a stale in-tree build can't make a cold build warm.
+var skipDirs = map[string]bool{
+	".git":       true,
+	"build":      true,
+	"dist":       true,
+	"target":     true,
+	"zig-out":    true,
+	".zig-cache": true,
+	"zig-cache":  true,
+}
+
+// Workspace is an out-of-tree copy of a benchmark source directory.
+// All compile, clean and run commands execute inside it so the
+// repository itself is never modified.
+type Workspace struct {
+	Root string // temporary directory owned by this workspace
+	Dir  string // copy of the source directory (where commands run)
+}
+
+// New copies srcDir into a fresh directory under root. The shared files,
+// named relative to srcDir's parent, are copied next to it because some
+// programs refer to inputs such as ../hotpath.cpp; nothing else of the
+// parent is copied.
+func New(root, name, srcDir string, shared []string) (*Workspace, error) {
+	w := Plan(root, name, srcDir)
+	wsRoot := w.Root
+	if err := os.MkdirAll(wsRoot, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create workspace for %s: %w", name, err)
+	}
+
+	parent := filepath.Dir(srcDir)
+	for _, f := range shared {
+		if err := Copy(filepath.Join(parent, f), filepath.Join(wsRoot, f)); err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", f, err)
+		}
+	}
+
+	if err := copyTree(srcDir, w.Dir); err != nil {
+		return nil, fmt.Errorf("failed to copy %s: %w", srcDir, err)
+	}
+	return w, nil
+}
+
+// Plan returns the workspace New would create, without creating it.
+func Plan(root, name, srcDir string) *Workspace {
+	wsRoot := filepath.Join(root, sanitize(name))
+	return &Workspace{Root: wsRoot, Dir: filepath.Join(wsRoot, filepath.Base(srcDir))}
+}
+
+// Path resolves a path relative to the workspace directory.
+func (w *Workspace) Path(rel string) string {
+	return filepath.Join(w.Dir, rel)
+}
+
+// Keep copies the given artifact (relative to the workspace directory)
+// into dst, preserving its base name.
+func (w *Workspace) Keep(rel, dst string) error {
+	return Copy(w.Path(rel), filepath.Join(dst, filepath.Base(rel)))
+}
+
+// Copy copies a file or directory tree from src to dst, creating the
+// parent directories of dst as needed.
+func Copy(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return copyTree(src, dst)
+	}
+	return copyFile(src, dst)
+}
+
+// MkdirTemp creates the per-run directory that holds all workspaces.
+// Each invocation gets its own directory so concurrent runs don't collide.
+func MkdirTemp(suiteName string) (string, error) {
+	return os.MkdirTemp("", tempPrefix(suiteName))
+}
+
+// TempPattern shows the path of the directory MkdirTemp creates, with a *
+// for its random part.
+func TempPattern(suiteName string) string {
+	return filepath.Join(os.TempDir(), tempPrefix(suiteName)+"*")
+}
+
+func tempPrefix(suiteName string) string {
+	return "benchrunner-" + sanitize(suiteName) + "-"
+}
+
+func sanitize(name string) string {
+	return strings.NewReplacer("/", "_", string(os.PathSeparator), "_", " ", "_").Replace(name)
+}
+
+// copyTree copies src into dst recursively, node_modules included, so
+// that installs and cleans in the copy never reach the source tree.
+// Symlinks to paths inside src are recreated as they are, since the copy
+// holds their targets too; relative links out of src are resolved against
+// its source location so that they point at the same file from dst.
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			if rel != "." && skipDirs[d.Name()] {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(target, 0755)
+		}
+
+		if d.Type()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(resolveLink(src, path, link), target)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+// resolveLink returns what the copy of the symlink at path, found while
+// copying src, points to: link itself if it is absolute or stays inside
+// src, otherwise the absolute path link resolves to from path
+func resolveLink(src, path, link string) string {
+	if filepath.IsAbs(link) {
+		return link
+	}
+	resolved := filepath.Join(filepath.Dir(path), link)
+	rel, err := filepath.Rel(src, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		abs, err := filepath.Abs(resolved)
+		if err != nil {
+			return resolved
+		}
+		return abs
+	}
+	return link
+}
+
+func copyFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveLink(t *testing.T) {
+	src := "/repo/benchmarks/node"
+	tests := []struct {
+		path, link string
+		want       string
+	}{
+		{path: "/repo/benchmarks/node/lib", link: "/usr/lib/node", want: "/usr/lib/node"},
+		{path: "/repo/benchmarks/node/main.js", link: "src/main.js", want: "src/main.js"},
+		{path: "/repo/benchmarks/node/a/b.js", link: "../c.js", want: "../c.js"},
+		{path: "/repo/benchmarks/node/input.txt", link: "../inputs/input.txt", want: "/repo/benchmarks/inputs/input.txt"},
+		{path: "/repo/benchmarks/node/a/b.js", link: "../../shared.js", want: "/repo/benchmarks/shared.js"},
+		{path: "/repo/benchmarks/node/self", link: "..", want: "/repo/benchmarks"},
+		// names merely starting with dots stay inside
+		{path: "/repo/benchmarks/node/x", link: "..hidden", want: "..hidden"},
+	}
+	for _, tt := range tests {
+		if got := resolveLink(src, tt.path, tt.link); got != tt.want {
+			t.Errorf("resolveLink(%q, %q, %q) = %q, want %q", src, tt.path, tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	repo := t.TempDir()
+	src := filepath.Join(repo, "node")
+	files := map[string]string{
+		"node/main.js":                   "main",
+		"node/node_modules/pkg/index.js": "pkg",
+		"node/dist/main.js":              "stale build",
+		"node/target/release/main":       "stale build",
+		"inputs/data.txt":                "data",
+		"hotpath.cpp":                    "shared",
+		"unrelated.txt":                  "not copied",
+	}
+	for name, content := range files {
+		path := filepath.Join(repo, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("../inputs/data.txt", filepath.Join(src, "data.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("main.js", filepath.Join(src, "index.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := New(t.TempDir(), "nodejs-build", src, []string{"hotpath.cpp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		rel      string // relative to the workspace directory
+		want     string // contents; "" for a path that must not exist
+		wantLink string // target of a symlink; "" for a regular file
+	}{
+		{rel: "main.js", want: "main"},
+		{rel: "node_modules/pkg/index.js", want: "pkg"},
+		{rel: "../hotpath.cpp", want: "shared"},
+		{rel: "../unrelated.txt"},
+		{rel: "dist/main.js"},
+		{rel: "target/release/main"},
+		// relative links out of the source resolve against it
+		{rel: "data.txt", want: "data", wantLink: filepath.Join(repo, "inputs/data.txt")},
+		{rel: "index.js", want: "main", wantLink: "main.js"},
+	}
+	for _, tt := range tests {
+		path := w.Path(tt.rel)
+		got, err := os.ReadFile(path)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s copied into the workspace", tt.rel)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s not copied: %v", tt.rel, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s = %q, want %q", tt.rel, got, tt.want)
+		}
+		info, err := os.Lstat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.wantLink == "" {
+			if !info.Mode().IsRegular() {
+				t.Errorf("%s is no regular file: %v", tt.rel, info.Mode())
+			}
+			continue
+		}
+		if link, err := os.Readlink(path); err != nil || link != tt.wantLink {
+			t.Errorf("%s links to %q (%v), want %q", tt.rel, link, err, tt.wantLink)
+		}
+	}
+
+	// writes into the copied node_modules never reach the source
+	if err := os.WriteFile(w.Path("node_modules/pkg/index.js"), []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(src, "node_modules/pkg/index.js")); string(got) != "pkg" {
+		t.Errorf("source node_modules changed to %q", got)
+	}
+}
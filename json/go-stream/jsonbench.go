@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+// checksum summarises a document independently of key order and number
+// formatting, so every language prints the same line.
+type checksum struct {
+	objects, arrays, keys, strings, numbers, bools, nulls int
+	stringBytes                                           int
+	numberSum                                             int64 // in hundredths
+}
+
+func (c checksum) String() string {
+	return fmt.Sprintf("objects=%d arrays=%d keys=%d strings=%d numbers=%d bools=%d nulls=%d string_bytes=%d number_sum=%d",
+		c.objects, c.arrays, c.keys, c.strings, c.numbers, c.bools, c.nulls, c.stringBytes, c.numberSum)
+}
+
+// transcode streams the tokens of data into out (when non-nil) without
+// building a document tree, and returns the document's checksum.
+func transcode(data []byte, out *bytes.Buffer) (checksum, error) {
+	var c checksum
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	// For each open container: whether it is an object, and how many
+	// tokens it has seen (in objects, even positions are keys)
+	type container struct {
+		object bool
+		n      int
+	}
+	var stack []container
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return c, nil
+		}
+		if err != nil {
+			return c, err
+		}
+
+		closing := tok == json.Delim('}') || tok == json.Delim(']')
+		isKey := false
+		if len(stack) > 0 && !closing {
+			top := &stack[len(stack)-1]
+			isKey = top.object && top.n%2 == 0
+			if out != nil && top.n > 0 {
+				if top.object && !isKey {
+					out.WriteByte(':')
+				} else {
+					out.WriteByte(',')
+				}
+			}
+			top.n++
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			switch v {
+			case '{':
+				c.objects++
+				stack = append(stack, container{object: true})
+			case '[':
+				c.arrays++
+				stack = append(stack, container{})
+			default:
+				stack = stack[:len(stack)-1]
+			}
+			if out != nil {
+				out.WriteByte(byte(v))
+			}
+		case string:
+			if isKey {
+				c.keys++
+			} else {
+				c.strings++
+			}
+			c.stringBytes += len(v)
+			if out != nil {
+				b, _ := json.Marshal(v)
+				out.Write(b)
+			}
+		case float64:
+			c.numbers++
+			c.numberSum += int64(math.Round(v * 100))
+			if out != nil {
+				out.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+			}
+		case bool:
+			c.bools++
+			if out != nil {
+				out.WriteString(strconv.FormatBool(v))
+			}
+		case nil:
+			c.nulls++
+			if out != nil {
+				out.WriteString("null")
+			}
+		}
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: jsonbench <file.json> [iterations]")
+		os.Exit(1)
+	}
+	iterations := 1
+	if len(os.Args) > 2 {
+		n, err := strconv.Atoi(os.Args[2])
+		if err != nil || n < 1 {
+			fmt.Fprintf(os.Stderr, "invalid iterations: %s\n", os.Args[2])
+			os.Exit(1)
+		}
+		iterations = n
+	}
+
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// A streaming transcoder has no separate serialize step: parse_time
+	// walks the tokens, and serialize_time is what re-encoding them adds
+	var sum checksum
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		if sum, err = transcode(data, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	parseTime := time.Since(start)
+
+	var out bytes.Buffer
+	start = time.Now()
+	for i := 0; i < iterations; i++ {
+		out.Reset()
+		if _, err := transcode(data, &out); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	transcodeTime := time.Since(start)
+
+	roundTrip, err := transcode(out.Bytes(), nil)
+	if err != nil || roundTrip != sum {
+		fmt.Fprintf(os.Stderr, "Error: re-serialized document differs (%v)\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("input: %d bytes\n", len(data))
+	fmt.Printf("checksum: %s\n", sum)
+	fmt.Println("roundtrip: ok")
+	fmt.Printf("METRIC parse_time=%.3f unit=us\n", float64(parseTime.Nanoseconds())/1e3/float64(iterations))
+	fmt.Printf("METRIC serialize_time=%.3f unit=us\n", float64(transcodeTime.Nanoseconds()-parseTime.Nanoseconds())/1e3/float64(iterations))
+}
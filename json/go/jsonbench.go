@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+// checksum summarises a parsed document independently of key order and
+// number formatting, so every language prints the same line.
+type checksum struct {
+	objects, arrays, keys, strings, numbers, bools, nulls int
+	stringBytes                                           int
+	numberSum                                             int64 // in hundredths
+}
+
+func (c *checksum) add(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		c.objects++
+		for k, child := range v {
+			c.keys++
+			c.stringBytes += len(k)
+			c.add(child)
+		}
+	case []interface{}:
+		c.arrays++
+		for _, child := range v {
+			c.add(child)
+		}
+	case string:
+		c.strings++
+		c.stringBytes += len(v)
+	case float64:
+		c.numbers++
+		c.numberSum += int64(math.Round(v * 100))
+	case bool:
+		c.bools++
+	case nil:
+		c.nulls++
+	}
+}
+
+func (c checksum) String() string {
+	return fmt.Sprintf("objects=%d arrays=%d keys=%d strings=%d numbers=%d bools=%d nulls=%d string_bytes=%d number_sum=%d",
+		c.objects, c.arrays, c.keys, c.strings, c.numbers, c.bools, c.nulls, c.stringBytes, c.numberSum)
+}
+
+func sumOf(data []byte) (checksum, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return checksum{}, err
+	}
+	var c checksum
+	c.add(doc)
+	return c, nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: jsonbench <file.json> [iterations]")
+		os.Exit(1)
+	}
+	iterations := 1
+	if len(os.Args) > 2 {
+		n, err := strconv.Atoi(os.Args[2])
+		if err != nil || n < 1 {
+			fmt.Fprintf(os.Stderr, "invalid iterations: %s\n", os.Args[2])
+			os.Exit(1)
+		}
+		iterations = n
+	}
+
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	var doc interface{}
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		doc = nil
+		if err := json.Unmarshal(data, &doc); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	parseTime := time.Since(start)
+
+	var out []byte
+	start = time.Now()
+	for i := 0; i < iterations; i++ {
+		if out, err = json.Marshal(doc); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	serializeTime := time.Since(start)
+
+	var sum checksum
+	sum.add(doc)
+	roundTrip, err := sumOf(out)
+	if err != nil || roundTrip != sum {
+		fmt.Fprintf(os.Stderr, "Error: re-serialized document differs (%v)\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("input: %d bytes\n", len(data))
+	fmt.Printf("checksum: %s\n", sum)
+	fmt.Println("roundtrip: ok")
+	fmt.Printf("METRIC parse_time=%.3f unit=us\n", float64(parseTime.Nanoseconds())/1e3/float64(iterations))
+	fmt.Printf("METRIC serialize_time=%.3f unit=us\n", float64(serializeTime.Nanoseconds())/1e3/float64(iterations))
+}
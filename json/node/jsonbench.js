@@ -0,0 +1,80 @@
+const fs = require('fs');
+
+// Summarises a parsed document independently of key order and number
+// formatting, so every language prints the same line.
+function newChecksum() {
+    return { objects: 0, arrays: 0, keys: 0, strings: 0, numbers: 0, bools: 0, nulls: 0, string_bytes: 0, number_sum: 0 };
+}
+
+function addChecksum(c, v) {
+    if (v === null) {
+        c.nulls++;
+    } else if (Array.isArray(v)) {
+        c.arrays++;
+        for (const child of v) addChecksum(c, child);
+    } else if (typeof v === 'object') {
+        c.objects++;
+        for (const k of Object.keys(v)) {
+            c.keys++;
+            c.string_bytes += Buffer.byteLength(k, 'utf8');
+            addChecksum(c, v[k]);
+        }
+    } else if (typeof v === 'string') {
+        c.strings++;
+        c.string_bytes += Buffer.byteLength(v, 'utf8');
+    } else if (typeof v === 'number') {
+        c.numbers++;
+        c.number_sum += Math.round(v * 100);
+    } else if (typeof v === 'boolean') {
+        c.bools++;
+    }
+}
+
+function formatChecksum(c) {
+    return Object.keys(c).map(k => `${k}=${c[k]}`).join(' ');
+}
+
+function main() {
+    if (process.argv.length < 3) {
+        console.error('usage: jsonbench.js <file.json> [iterations]');
+        process.exit(1);
+    }
+    const iterations = process.argv.length > 3 ? parseInt(process.argv[3], 10) : 1;
+    if (!(iterations >= 1)) {
+        console.error(`invalid iterations: ${process.argv[3]}`);
+        process.exit(1);
+    }
+
+    const data = fs.readFileSync(process.argv[2], 'utf8');
+
+    let doc;
+    let start = process.hrtime.bigint();
+    for (let i = 0; i < iterations; i++) {
+        doc = JSON.parse(data);
+    }
+    const parseTime = Number(process.hrtime.bigint() - start);
+
+    let out;
+    start = process.hrtime.bigint();
+    for (let i = 0; i < iterations; i++) {
+        out = JSON.stringify(doc);
+    }
+    const serializeTime = Number(process.hrtime.bigint() - start);
+
+    const sum = newChecksum();
+    addChecksum(sum, doc);
+    const roundTrip = newChecksum();
+    addChecksum(roundTrip, JSON.parse(out));
+    if (formatChecksum(roundTrip) !== formatChecksum(sum)) {
+        console.error('Error: re-serialized document differs');
+        process.exit(1);
+    }
+
+    console.log(`input: ${Buffer.byteLength(data, 'utf8')} bytes`);
+    console.log(`checksum: ${formatChecksum(sum)}`);
+    console.log('roundtrip: ok');
+    console.log(`METRIC parse_time=${(parseTime / 1e3 / iterations).toFixed(3)} unit=us`);
+    console.log(`METRIC serialize_time=${(serializeTime / 1e3 / iterations).toFixed(3)} unit=us`);
+}
+
+main();
@@ -0,0 +1,80 @@
+import json
+import sys
+import time
+
+
+class Checksum:
+    """Summarises a parsed document independently of key order and number
+    formatting, so every language prints the same line."""
+
+    FIELDS = ("objects", "arrays", "keys", "strings", "numbers", "bools", "nulls", "string_bytes", "number_sum")
+
+    def __init__(self):
+        for field in self.FIELDS:
+            setattr(self, field, 0)
+
+    def add(self, v):
+        if v is None:
+            self.nulls += 1
+        elif isinstance(v, bool):
+            self.bools += 1
+        elif isinstance(v, dict):
+            self.objects += 1
+            for k, child in v.items():
+                self.keys += 1
+                self.string_bytes += len(k.encode("utf-8"))
+                self.add(child)
+        elif isinstance(v, list):
+            self.arrays += 1
+            for child in v:
+                self.add(child)
+        elif isinstance(v, str):
+            self.strings += 1
+            self.string_bytes += len(v.encode("utf-8"))
+        elif isinstance(v, (int, float)):
+            self.numbers += 1
+            self.number_sum += round(v * 100)
+
+    def __str__(self):
+        return " ".join(f"{field}={getattr(self, field)}" for field in self.FIELDS)
+
+
+def main():
+    if len(sys.argv) < 2:
+        print("usage: jsonbench.py <file.json> [iterations]", file=sys.stderr)
+        sys.exit(1)
+    iterations = int(sys.argv[2]) if len(sys.argv) > 2 else 1
+    if iterations < 1:
+        print(f"invalid iterations: {sys.argv[2]}", file=sys.stderr)
+        sys.exit(1)
+
+    with open(sys.argv[1], "rb") as f:
+        data = f.read()
+
+    start = time.perf_counter_ns()
+    for _ in range(iterations):
+        doc = json.loads(data)
+    parse_time = time.perf_counter_ns() - start
+
+    start = time.perf_counter_ns()
+    for _ in range(iterations):
+        out = json.dumps(doc, ensure_ascii=False, separators=(",", ":"))
+    serialize_time = time.perf_counter_ns() - start
+
+    checksum = Checksum()
+    checksum.add(doc)
+    round_trip = Checksum()
+    round_trip.add(json.loads(out))
+    if str(round_trip) != str(checksum):
+        print("Error: re-serialized document differs", file=sys.stderr)
+        sys.exit(1)
+
+    print(f"input: {len(data)} bytes")
+    print(f"checksum: {checksum}")
+    print("roundtrip: ok")
+    print(f"METRIC parse_time={parse_time / 1e3 / iterations:.3f} unit=us")
+    print(f"METRIC serialize_time={serialize_time / 1e3 / iterations:.3f} unit=us")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,115 @@
+use serde_json::Value;
+use std::env;
+use std::fmt;
+use std::fs;
+use std::process;
+use std::time::Instant;
+
+/// Summarises a parsed document independently of key order and number
+/// formatting, so every language prints the same line.
+#[derive(Default, PartialEq)]
+struct Checksum {
+    objects: u64,
+    arrays: u64,
+    keys: u64,
+    strings: u64,
+    numbers: u64,
+    bools: u64,
+    nulls: u64,
+    string_bytes: u64,
+    number_sum: i64, // in hundredths
+}
+
+impl Checksum {
+    fn add(&mut self, v: &Value) {
+        match v {
+            Value::Null => self.nulls += 1,
+            Value::Bool(_) => self.bools += 1,
+            Value::Number(n) => {
+                self.numbers += 1;
+                self.number_sum += (n.as_f64().unwrap_or(0.0) * 100.0).round() as i64;
+            }
+            Value::String(s) => {
+                self.strings += 1;
+                self.string_bytes += s.len() as u64;
+            }
+            Value::Array(items) => {
+                self.arrays += 1;
+                for item in items {
+                    self.add(item);
+                }
+            }
+            Value::Object(fields) => {
+                self.objects += 1;
+                for (k, item) in fields {
+                    self.keys += 1;
+                    self.string_bytes += k.len() as u64;
+                    self.add(item);
+                }
+            }
+        }
+    }
+}
+
+impl fmt::Display for Checksum {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        write!(
+            f,
+            "objects={} arrays={} keys={} strings={} numbers={} bools={} nulls={} string_bytes={} number_sum={}",
+            self.objects, self.arrays, self.keys, self.strings, self.numbers, self.bools, self.nulls,
+            self.string_bytes, self.number_sum
+        )
+    }
+}
+
+fn fail(msg: String) -> ! {
+    eprintln!("{}", msg);
+    process::exit(1);
+}
+
+fn main() {
+    let args: Vec<String> = env::args().collect();
+    if args.len() < 2 {
+        fail("usage: jsonbench <file.json> [iterations]".to_string());
+    }
+    let iterations: u32 = match args.get(2) {
+        Some(s) => match s.parse() {
+            Ok(n) if n >= 1 => n,
+            _ => fail(format!("invalid iterations: {}", s)),
+        },
+        None => 1,
+    };
+
+    let data = fs::read(&args[1]).unwrap_or_else(|e| fail(format!("Error: {}", e)));
+
+    let start = Instant::now();
+    let mut doc = Value::Null;
+    for _ in 0..iterations {
+        doc = serde_json::from_slice(&data).unwrap_or_else(|e| fail(format!("Error: {}", e)));
+    }
+    let parse_time = start.elapsed();
+
+    let start = Instant::now();
+    let mut out = Vec::new();
+    for _ in 0..iterations {
+        out = serde_json::to_vec(&doc).unwrap_or_else(|e| fail(format!("Error: {}", e)));
+    }
+    let serialize_time = start.elapsed();
+
+    let mut checksum = Checksum::default();
+    checksum.add(&doc);
+    let mut round_trip = Checksum::default();
+    match serde_json::from_slice::<Value>(&out) {
+        Ok(v) => round_trip.add(&v),
+        Err(e) => fail(format!("Error: re-serialized document differs ({})", e)),
+    }
+    if round_trip != checksum {
+        fail("Error: re-serialized document differs".to_string());
+    }
+
+    println!("input: {} bytes", data.len());
+    println!("checksum: {}", checksum);
+    println!("roundtrip: ok");
+    println!("METRIC parse_time={:.3} unit=us", parse_time.as_nanos() as f64 / 1e3 / iterations as f64);
+    println!("METRIC serialize_time={:.3} unit=us", serialize_time.as_nanos() as f64 / 1e3 / iterations as f64);
+}
@@ -0,0 +1,270 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	"github.com/spf13/pflag"
+)
+
+// Report types, as saved in the results directory by RunAll.
+type (
+	// Report is the outcome of several suites run in sequence.
+	Report = results.Report
+	// SuiteReport is the section of a Report for one suite.
+	SuiteReport = results.SuiteReport
+	// Summary counts the suites and variants of a Report by status.
+	Summary = results.Summary
+	// Failure is a suite or variant that did not complete, or a skipped
+	// suite, and why.
+	Failure = results.Failure
+)
+
+// StatusSkipped marks the suites of a Report whose variants the targets
+// don't select.
+const StatusSkipped = results.StatusSkipped
+
+// EventReport is sent with the Report once RunAll is done.
+const EventReport = suite.EventReport
+
+// AllConfig configures a RunAll: the suites to run and the options they
+// share.
+type AllConfig struct {
+	Options
+
+	BaseDir string   // repository root
+	Suites  []string // suites to run, in order; empty runs every suite
+	Targets []string // variants to run in every suite; empty runs all
+
+	// Settings holds values for the suites' own flags by suite and flag
+	// name, e.g. {"compute": {"sizes": "1e3,1e4"}}.
+	Settings map[string]map[string]string
+
+	// ResultsDir is where the report is saved; empty means it is only
+	// returned. The suites' results are part of the report rather than
+	// saved on their own.
+	ResultsDir string
+
+	// Progress, if set, is called on every event of every suite and with
+	// the report at the end.
+	Progress func(Event)
+
+	// Log receives the progress logs of every suite; nil means standard
+	// output.
+	Log io.Writer
+}
+
+// DefaultAllConfig returns the configuration benchrunner run all uses when
+// no flag is given, for the repository that contains the working
+// directory.
+func DefaultAllConfig() (AllConfig, error) {
+	cfg, err := DefaultConfig("helloworld")
+	if err != nil {
+		return AllConfig{}, err
+	}
+	return AllConfig{
+		Options:    cfg.Options,
+		BaseDir:    cfg.BaseDir,
+		ResultsDir: cfg.ResultsDir,
+	}, nil
+}
+
+// AllFlagSet returns the command-line flags of RunAll, bound to cfg: the
+// shared options, --suites and --set suite.flag=value for the suites' own
+// flags.
+func AllFlagSet(cfg *AllConfig) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("all", pflag.ContinueOnError)
+	suite.BindFlags(fs, suite.ProgramFlags, &cfg.Options)
+	fs.StringSliceVar(&cfg.Suites, "suites", cfg.Suites, "Comma-separated suites to run, in order (default: every suite)")
+	fs.Var(&suiteSetting{cfg: cfg}, "set", "Set a suite's own flag as suite.flag=value, e.g. compute.sizes=1e5 (repeatable)")
+	return fs
+}
+
+// suiteSetting is the --set flag, which stores suite flags in
+// AllConfig.Settings
+type suiteSetting struct {
+	cfg *AllConfig
+	set []string
+}
+
+func (s *suiteSetting) Set(value string) error {
+	name, val, ok := strings.Cut(value, "=")
+	suiteName, flag, dotted := strings.Cut(name, ".")
+	if !ok || !dotted {
+		return fmt.Errorf("expected suite.flag=value, got %q", value)
+	}
+	if err := CheckSetting(s.cfg.BaseDir, suiteName, flag, val); err != nil {
+		return err
+	}
+	if s.cfg.Settings == nil {
+		s.cfg.Settings = make(map[string]map[string]string)
+	}
+	if s.cfg.Settings[suiteName] == nil {
+		s.cfg.Settings[suiteName] = make(map[string]string)
+	}
+	s.cfg.Settings[suiteName][flag] = val
+	s.set = append(s.set, value)
+	return nil
+}
+
+func (s *suiteSetting) String() string { return strings.Join(s.set, " ") }
+
+func (s *suiteSetting) Type() string { return "suite.flag=value" }
+
+// CheckSetting reports whether the suite has a flag of its own with the
+// name and accepts the value for it.
+func CheckSetting(baseDir, suiteName, flag, value string) error {
+	r, found := suite.Lookup(suiteName)
+	if !found {
+		return fmt.Errorf("unknown suite: %s", suiteName)
+	}
+	_, fs := newSuite(r, baseDir)
+	if fs.Lookup(flag) == nil {
+		return fmt.Errorf("suite %s has no setting %q", suiteName, flag)
+	}
+	if err := fs.Set(flag, value); err != nil {
+		return fmt.Errorf("invalid %s.%s: %w", suiteName, flag, err)
+	}
+	return nil
+}
+
+// RunAll runs the suites of cfg one after another with the same options
+// and targets, and returns one report covering all of them. A suite that
+// fails doesn't stop the others; a suite none of whose variants the
+// targets select is skipped. Once ctx is done, the running suite stops as
+// Run does and the remaining ones are recorded as interrupted. The report
+// is returned, and saved, in every case; the error is only set if the
+// suites could not be set up or ctx is done.
+func RunAll(ctx context.Context, cfg AllConfig) (*Report, error) {
+	if cfg.Log == nil {
+		cfg.Log = os.Stdout
+	}
+	configs, err := cfg.configs()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Timestamp: time.Now(), Targets: cfg.Targets}
+	for i, c := range configs {
+		fmt.Fprintln(cfg.Log, "\n"+strings.Repeat("#", 80))
+		fmt.Fprintf(cfg.Log, "# [%d/%d] %s\n", i+1, len(configs), c.Suite)
+		fmt.Fprintln(cfg.Log, strings.Repeat("#", 80))
+
+		section := SuiteReport{Suite: c.Suite, Status: results.StatusOK}
+		if ctx.Err() != nil {
+			section.Status = results.StatusInterrupted
+			section.Error = "not started"
+			report.Suites = append(report.Suites, section)
+			continue
+		}
+
+		start := time.Now()
+		res, err := Run(ctx, c)
+		section.Duration = time.Since(start).Seconds()
+		section.Results = res
+		var noVariants *suite.NoVariantsError
+		switch {
+		case errors.As(err, &noVariants):
+			section.Status = StatusSkipped
+			section.Error = err.Error()
+			fmt.Fprintf(cfg.Log, "Skipping %s: %v\n", c.Suite, err)
+		case err != nil && ctx.Err() != nil:
+			section.Status = results.StatusInterrupted
+			section.Error = err.Error()
+		case err != nil:
+			section.Status = results.StatusFailed
+			section.Error = err.Error()
+			fmt.Fprintf(cfg.Log, "ERROR: %s failed: %v\n", c.Suite, err)
+		}
+		report.Suites = append(report.Suites, section)
+	}
+	report.Duration = time.Since(report.Timestamp).Seconds()
+	report.Summarize()
+
+	if cfg.ResultsDir != "" {
+		if path, err := results.SaveReport(cfg.ResultsDir, report); err != nil {
+			fmt.Fprintf(cfg.Log, "WARNING: Failed to save report: %v\n", err)
+		} else {
+			fmt.Fprintf(cfg.Log, "\nReport saved to: %s\n", path)
+		}
+	}
+	if cfg.Progress != nil {
+		cfg.Progress(Event{Kind: EventReport, Time: time.Now(), Suite: "all", Report: report})
+	}
+	return report, ctx.Err()
+}
+
+// MakePlans resolves the plan of every suite RunAll would run for cfg.
+// Suites it would skip have a plan without benchmarks, with the reason in
+// Skipped.
+func MakePlans(cfg AllConfig) ([]*Plan, error) {
+	configs, err := cfg.configs()
+	if err != nil {
+		return nil, err
+	}
+	var plans []*Plan
+	for _, c := range configs {
+		plan, err := MakePlan(c)
+		var noVariants *suite.NoVariantsError
+		if errors.As(err, &noVariants) {
+			plan, err = &Plan{Suite: c.Suite, Skipped: err.Error()}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Suite, err)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// configs returns the configuration of every suite of the run, checking
+// the suite names and settings before anything runs
+func (cfg AllConfig) configs() ([]Config, error) {
+	if cfg.BaseDir == "" {
+		return nil, fmt.Errorf("no base directory")
+	}
+	var names []string
+	for _, name := range cfg.Suites {
+		names = append(names, strings.TrimSpace(name))
+	}
+	if len(names) == 0 {
+		for _, r := range suite.All() {
+			names = append(names, r.Name)
+		}
+	}
+	for name := range cfg.Settings {
+		found := false
+		for _, n := range names {
+			found = found || n == name
+		}
+		if !found {
+			return nil, fmt.Errorf("settings for %s, which is not run", name)
+		}
+	}
+
+	var configs []Config
+	for _, name := range names {
+		c := Config{
+			Options:  cfg.Options,
+			Suite:    name,
+			BaseDir:  cfg.BaseDir,
+			Targets:  cfg.Targets,
+			Settings: cfg.Settings[name],
+			Progress: cfg.Progress,
+			Log:      cfg.Log,
+		}
+		// Check the suite and its settings up front
+		if _, _, _, err := configure(c); err != nil {
+			return nil, err
+		}
+		configs = append(configs, c)
+	}
+	return configs, nil
+}
@@ -0,0 +1,315 @@
+// Package bench runs the repository's benchmark suites from Go code. It is
+// what the benchrunner command is built on:
+//
+//	cfg, err := bench.DefaultConfig("compute")
+//	if err != nil {
+//		return err
+//	}
+//	cfg.Targets = []string{"go", "rust"}
+//	cfg.Settings = map[string]string{"kernels": "n-body", "sizes": "1e5,1e6"}
+//	cfg.Progress = func(e bench.Event) { log.Println(e.Kind, e.Phase) }
+//	res, err := bench.Run(ctx, cfg)
+//
+// Suites write their progress logs, and the output of the tools and
+// servers they run, to cfg.Log as they run (standard output if it is nil);
+// Progress receives the same progress as structured events.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/benchmarks/internal/measure"
+	"github.com/benchmarks/internal/metrics"
+	"github.com/benchmarks/internal/results"
+	"github.com/benchmarks/internal/suite"
+	_ "github.com/benchmarks/internal/suites" // registers the suites
+	"github.com/spf13/pflag"
+)
+
+// Result types, as saved in the results directory.
+type (
+	// Result is the outcome of one benchmark: a suite, or one of its
+	// sub-benchmarks at one set of parameters.
+	Result = results.Suite
+	// Variant is the outcome of one variant within a Result.
+	Variant = results.Variant
+	// Stats summarises timings, in milliseconds.
+	Stats = measure.Stats
+	// Metric summarises a metric the programs or the runner reported.
+	Metric = metrics.Summary
+)
+
+// Variant status values.
+const (
+	StatusOK          = results.StatusOK
+	StatusFailed      = results.StatusFailed
+	StatusTimeout     = results.StatusTimeout
+	StatusInterrupted = results.StatusInterrupted
+)
+
+// Options are the settings shared by the suites: warmup and measured
+// runs, mode, benchmark tool, build jobs, step timeouts and the order of
+// the iterations. Each suite only uses the options it has flags for.
+type Options = suite.Options
+
+// Orders of the iterations of the variants (Options.Order).
+const (
+	OrderSequential  = suite.OrderSequential
+	OrderInterleaved = suite.OrderInterleaved
+	OrderRandom      = suite.OrderRandom
+)
+
+// Event reports the progress of a run: the start of a phase (prepare,
+// verify, run, cleanup), a variant starting, a measured run of it (for
+// the variants the runner times itself rather than poop or hyperfine), a
+// variant's outcome, a recorded Result, and the summary at the end.
+type Event = suite.Event
+
+// EventKind is the kind of an Event.
+type EventKind = suite.EventKind
+
+// Event kinds.
+const (
+	EventPhase        = suite.EventPhase
+	EventVariantStart = suite.EventVariantStart
+	EventSample       = suite.EventSample
+	EventVariantDone  = suite.EventVariantDone
+	EventResult       = suite.EventResult
+	EventSummary      = suite.EventSummary
+)
+
+// Plan types, as MakePlan resolves them.
+type (
+	// Plan is what a run of a suite would execute.
+	Plan = suite.Plan
+	// PlanBenchmark is a benchmark of a Plan, with its variants.
+	PlanBenchmark = suite.PlanBenchmark
+	// PlanVariant is a selected variant and the commands run for it.
+	PlanVariant = suite.PlanVariant
+	// PlanStep is a command run in a phase.
+	PlanStep = suite.PlanStep
+	// PlanSkip is a variant that won't run, and why.
+	PlanSkip = suite.PlanSkip
+)
+
+// SuiteInfo describes a suite.
+type SuiteInfo struct {
+	Name     string   `json:"name"`
+	Args     string   `json:"args"`  // usage of the positional arguments (the targets)
+	Short    string   `json:"short"` // one-line description
+	Long     string   `json:"-"`     // full description
+	Variants []string `json:"variants"`
+
+	// Tags are the tags of every variant, by variant name, for selectors
+	// such as --select lang=rust
+	Tags map[string]map[string]string `json:"tags"`
+}
+
+// Suites returns every suite, sorted by name, with the variants it has in
+// the repository at baseDir.
+func Suites(baseDir string) []SuiteInfo {
+	var infos []SuiteInfo
+	for _, r := range suite.All() {
+		info := SuiteInfo{Name: r.Name, Args: r.Args, Short: r.Short, Long: r.Long}
+		if info.Args == "" {
+			info.Args = "[language]"
+		}
+		info.Tags = make(map[string]map[string]string)
+		for _, v := range r.New(baseDir).Variants() {
+			info.Variants = append(info.Variants, v.Name)
+			info.Tags[v.Name] = v.Tags
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// FormatTags formats variant tags as "key=value" pairs sorted by key.
+func FormatTags(tags map[string]string) string {
+	return suite.FormatTags(tags)
+}
+
+// Config configures a Run.
+type Config struct {
+	Options
+
+	Suite   string   // name of the suite to run
+	BaseDir string   // repository root
+	Targets []string // variant selectors, as on the command line ("go", "node,rust"); empty runs all
+
+	// Settings holds values for the suite's own flags by flag name, e.g.
+	// {"sizes": "1e3,1e4"} for compute. Unset flags keep their defaults.
+	Settings map[string]string
+
+	// ResultsDir is where results are saved; empty means they are only
+	// returned.
+	ResultsDir string
+
+	// Progress, if set, is called on every event of the run, from the
+	// goroutine that called Run.
+	Progress func(Event)
+
+	// Log receives the progress logs of the run and the output of the
+	// programs it runs; nil means standard output.
+	Log io.Writer
+}
+
+// DefaultConfig returns the configuration the benchrunner command uses for
+// the suite when no flag is given, for the repository that contains the
+// working directory.
+func DefaultConfig(suiteName string) (Config, error) {
+	if _, ok := suite.Lookup(suiteName); !ok {
+		return Config{}, fmt.Errorf("unknown suite: %s", suiteName)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return Config{}, err
+	}
+	baseDir := FindRepoRoot(wd)
+	return Config{
+		Options:    suite.DefaultOptions(),
+		Suite:      suiteName,
+		BaseDir:    baseDir,
+		ResultsDir: filepath.Join(baseDir, "results"),
+	}, nil
+}
+
+// FindRepoRoot returns the closest directory from start upwards that holds
+// a go.mod, or start itself if there is none.
+func FindRepoRoot(start string) string {
+	dir := start
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return start
+		}
+		dir = parent
+	}
+}
+
+// FlagSet returns the command-line flags of cfg.Suite: the shared options
+// the suite uses, bound to cfg.Options, and the suite's own flags, which
+// store their values in cfg.Settings.
+func FlagSet(cfg *Config) (*pflag.FlagSet, error) {
+	r, ok := suite.Lookup(cfg.Suite)
+	if !ok {
+		return nil, fmt.Errorf("unknown suite: %s", cfg.Suite)
+	}
+	fs := pflag.NewFlagSet(cfg.Suite, pflag.ContinueOnError)
+	suite.BindFlags(fs, r.Flags, &cfg.Options)
+
+	_, own := newSuite(r, cfg.BaseDir)
+	own.VisitAll(func(f *pflag.Flag) {
+		fs.AddFlag(&pflag.Flag{
+			Name:        f.Name,
+			Shorthand:   f.Shorthand,
+			Usage:       f.Usage,
+			DefValue:    f.DefValue,
+			NoOptDefVal: f.NoOptDefVal, // lets boolean flags go without a value
+			Value:       &setting{flag: f, cfg: cfg},
+		})
+	})
+	return fs, nil
+}
+
+// setting is a suite flag whose value is kept in Config.Settings. The
+// suite's own flag validates it.
+type setting struct {
+	flag *pflag.Flag
+	cfg  *Config
+}
+
+func (s *setting) Set(value string) error {
+	if err := s.flag.Value.Set(value); err != nil {
+		return err
+	}
+	if s.cfg.Settings == nil {
+		s.cfg.Settings = make(map[string]string)
+	}
+	s.cfg.Settings[s.flag.Name] = value
+	return nil
+}
+
+func (s *setting) String() string { return s.flag.Value.String() }
+
+func (s *setting) Type() string { return s.flag.Value.Type() }
+
+// newSuite returns a new instance of the suite and the flags it defines
+// for itself, bound to that instance
+func newSuite(r suite.Registration, baseDir string) (suite.Suite, *pflag.FlagSet) {
+	s := r.New(baseDir)
+	fs := pflag.NewFlagSet(r.Name, pflag.ContinueOnError)
+	if f, ok := s.(suite.Flagger); ok {
+		f.Flags(fs)
+	}
+	return s, fs
+}
+
+// Run runs the suite cfg selects and returns the results it recorded, in
+// order. The results recorded before a failure or cancellation are
+// returned along with the error. Once ctx is done, the run kills the
+// programs and servers it is running, records what it measured with the
+// variants it cut short as StatusInterrupted, and cleans up.
+func Run(ctx context.Context, cfg Config) ([]*Result, error) {
+	name, s, suiteCfg, err := configure(cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = suite.Execute(ctx, name, s, suiteCfg)
+	return suiteCfg.Results, err
+}
+
+// MakePlan resolves what Run would execute for cfg, without executing
+// anything: the variants selected and skipped, every command per phase and
+// the benchmark tool invocations.
+func MakePlan(cfg Config) (*Plan, error) {
+	name, s, suiteCfg, err := configure(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return suite.MakePlan(name, s, suiteCfg)
+}
+
+// configure creates the suite cfg selects, applies its settings and
+// returns it with its configuration
+func configure(cfg Config) (string, suite.Suite, *suite.Config, error) {
+	r, ok := suite.Lookup(cfg.Suite)
+	if !ok {
+		return "", nil, nil, fmt.Errorf("unknown suite: %s", cfg.Suite)
+	}
+	if cfg.BaseDir == "" {
+		return "", nil, nil, fmt.Errorf("no base directory")
+	}
+
+	s, fs := newSuite(r, cfg.BaseDir)
+	names := make([]string, 0, len(cfg.Settings))
+	for name := range cfg.Settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if fs.Lookup(name) == nil {
+			return "", nil, nil, fmt.Errorf("suite %s has no setting %q", cfg.Suite, name)
+		}
+		if err := fs.Set(name, cfg.Settings[name]); err != nil {
+			return "", nil, nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	return r.Name, s, &suite.Config{
+		Options:    cfg.Options,
+		BaseDir:    cfg.BaseDir,
+		Targets:    cfg.Targets,
+		ResultsDir: cfg.ResultsDir,
+		Progress:   cfg.Progress,
+		Log:        cfg.Log,
+	}, nil
+}
@@ -118,6 +118,9 @@ fn benchmark_capnproto(records: &[TestRecord]) -> Vec<u8> {
         }
         
         let encoded = serialize::write_message_to_words(&message);
+        buffer.extend_from_slice(&encoded);
+    }
+
     buffer
 }
 
@@ -141,6 +144,16 @@ fn encoding_benchmarks(c: &mut Criterion) {
         let data_size = size * (8 + 20 + 8 + 12 + 64); // Approximate size per record
         
         group.throughput(Throughput::Bytes(data_size as u64));
+
+        // Encoded sizes, picked up by `benchrunner run serialization`
+        for (format, encoded) in [
+            ("avro", benchmark_avro(&records)),
+            ("protobuf", benchmark_protobuf(&records)),
+            ("capnproto", benchmark_capnproto(&records)),
+            ("fory", benchmark_fory(&records)),
+        ] {
+            println!("METRIC {}_{}_size={} unit=bytes", format, size, encoded.len());
+        }
         
         group.bench_with_input(BenchmarkId::new("Avro", size), &records, |b, records| {
             b.iter(|| {
@@ -0,0 +1,185 @@
+// Go implementation of the serialization benchmark: encodes the same
+// records as benches/serialization_bench.rs with encoding/json,
+// encoding/gob and a hand-rolled protobuf wire encoder for
+// proto/benchmark.proto.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	samples    = 10
+	sampleTime = 100 * time.Millisecond
+)
+
+// Record mirrors BenchmarkData in proto/benchmark.proto.
+type Record struct {
+	ID      int64
+	Name    string
+	Value   float64
+	Numbers []int32
+	Data    []byte
+}
+
+// generateTestData builds the same records as the Rust benchmark.
+func generateTestData(size int) []Record {
+	records := make([]Record, size)
+	for i := range records {
+		records[i] = Record{
+			ID:      int64(i),
+			Name:    fmt.Sprintf("Record_%d", i),
+			Value:   float64(i) * 1.5,
+			Numbers: []int32{int32(i), int32(i + 1), int32(i + 2)},
+			Data:    make([]byte, 64),
+		}
+	}
+	return records
+}
+
+func encodeJSON(records []Record) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i := range records {
+		if err := enc.Encode(&records[i]); err != nil {
+			panic(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+// encodeGob uses one encoder per batch, so the type description is
+// written once rather than per record.
+func encodeGob(records []Record) []byte {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	for i := range records {
+		if err := enc.Encode(&records[i]); err != nil {
+			panic(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+// encodeProtobuf writes each record as a BenchmarkData message, back to
+// back like the Rust benchmark does with prost. Like proto3, fields with
+// default values are omitted.
+func encodeProtobuf(records []Record) []byte {
+	var buf []byte
+	for i := range records {
+		buf = appendRecord(buf, &records[i])
+	}
+	return buf
+}
+
+func appendRecord(b []byte, r *Record) []byte {
+	if r.ID != 0 {
+		b = appendTag(b, 1, 0)
+		b = binary.AppendUvarint(b, uint64(r.ID))
+	}
+	if r.Name != "" {
+		b = appendTag(b, 2, 2)
+		b = binary.AppendUvarint(b, uint64(len(r.Name)))
+		b = append(b, r.Name...)
+	}
+	if r.Value != 0 {
+		b = appendTag(b, 3, 1)
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(r.Value))
+	}
+	if len(r.Numbers) > 0 {
+		// repeated int32 is packed; negative values take 10 bytes
+		n := 0
+		for _, v := range r.Numbers {
+			n += uvarintLen(uint64(int64(v)))
+		}
+		b = appendTag(b, 4, 2)
+		b = binary.AppendUvarint(b, uint64(n))
+		for _, v := range r.Numbers {
+			b = binary.AppendUvarint(b, uint64(int64(v)))
+		}
+	}
+	if len(r.Data) > 0 {
+		b = appendTag(b, 5, 2)
+		b = binary.AppendUvarint(b, uint64(len(r.Data)))
+		b = append(b, r.Data...)
+	}
+	return b
+}
+
+func appendTag(b []byte, field, wireType uint64) []byte {
+	return binary.AppendUvarint(b, field<<3|wireType)
+}
+
+func uvarintLen(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+var sink []byte
+
+// measure returns the time per call of encode over samples batches of
+// calls, each batch taking about sampleTime.
+func measure(encode func([]Record) []byte, records []Record) []float64 {
+	start := time.Now()
+	sink = encode(records)
+	iters := int(sampleTime / (time.Since(start) + 1))
+	if iters < 1 {
+		iters = 1
+	}
+
+	// Warm up for one batch before sampling
+	for i := 0; i < iters; i++ {
+		sink = encode(records)
+	}
+
+	perCall := make([]float64, samples)
+	for s := range perCall {
+		start := time.Now()
+		for i := 0; i < iters; i++ {
+			sink = encode(records)
+		}
+		perCall[s] = float64(time.Since(start).Nanoseconds()) / float64(iters)
+	}
+	return perCall
+}
+
+func main() {
+	formats := []struct {
+		name   string
+		encode func([]Record) []byte
+	}{
+		{"json", encodeJSON},
+		{"gob", encodeGob},
+		{"protobuf", encodeProtobuf},
+	}
+
+	for _, size := range []int{100, 1000, 10000} {
+		records := generateTestData(size)
+		for _, f := range formats {
+			encoded := len(f.encode(records))
+			perCall := measure(f.encode, records)
+
+			mean := 0.0
+			for _, ns := range perCall {
+				mean += ns
+			}
+			mean /= float64(len(perCall))
+
+			fmt.Printf("%-8s %6d records: %12.2f ns/batch, %8d bytes\n", f.name, size, mean, encoded)
+			for _, ns := range perCall {
+				fmt.Printf("METRIC %s_%d_encode=%.2f unit=ns\n", f.name, size, ns)
+			}
+			fmt.Printf("METRIC %s_%d_size=%d unit=bytes\n", f.name, size, encoded)
+		}
+	}
+}
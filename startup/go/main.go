@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Reports the wall-clock time main was entered, then idles until stdin is
+// closed so the runner can sample the process's memory.
+func main() {
+	now := time.Now().UnixNano()
+	fmt.Printf("main %d\n", now)
+
+	io.Copy(io.Discard, os.Stdin)
+}
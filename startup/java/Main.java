@@ -0,0 +1,16 @@
+import java.io.IOException;
+import java.time.Instant;
+
+// Reports the wall-clock time main was entered, then idles until stdin is
+// closed so the runner can sample the process's memory.
+public class Main {
+    public static void main(String[] args) throws IOException {
+        Instant now = Instant.now();
+        System.out.println("main " + (now.getEpochSecond() * 1_000_000_000L + now.getNano()));
+        System.out.flush();
+
+        byte[] buf = new byte[64];
+        while (System.in.read(buf) > 0) {
+        }
+    }
+}
@@ -0,0 +1,12 @@
+// Reports the wall-clock time main was entered, then idles until stdin is
+// closed so the runner can sample the process's memory.
+
+function main() {
+  const now = BigInt(Math.round((performance.timeOrigin + performance.now()) * 1e3)) * 1000n;
+  process.stdout.write(`main ${now}\n`);
+
+  process.stdin.resume();
+  process.stdin.on("end", () => process.exit(0));
+}
+
+main();
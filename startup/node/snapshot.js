@@ -0,0 +1,13 @@
+// Entry point for `node --build-snapshot`: main runs after the snapshot is
+// deserialized, so startup skips compiling and running module code.
+const v8 = require("v8");
+
+function main() {
+  const now = BigInt(Math.round((performance.timeOrigin + performance.now()) * 1e3)) * 1000n;
+  process.stdout.write(`main ${now}\n`);
+
+  process.stdin.resume();
+  process.stdin.on("end", () => process.exit(0));
+}
+
+v8.startupSnapshot.setDeserializeMainFunction(main);
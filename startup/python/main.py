@@ -0,0 +1,11 @@
+# Reports the wall-clock time main was entered, then idles until stdin is
+# closed so the runner can sample the process's memory.
+import time
+
+now = time.time_ns()
+
+import sys
+
+sys.stdout.write(f"main {now}\n")
+sys.stdout.flush()
+sys.stdin.buffer.read()
@@ -0,0 +1,14 @@
+use std::io::{self, Read, Write};
+use std::time::{SystemTime, UNIX_EPOCH};
+
+// Reports the wall-clock time main was entered, then idles until stdin is
+// closed so the runner can sample the process's memory.
+fn main() {
+    let now = SystemTime::now().duration_since(UNIX_EPOCH).unwrap().as_nanos();
+    let mut stdout = io::stdout();
+    writeln!(stdout, "main {}", now).unwrap();
+    stdout.flush().unwrap();
+
+    let mut buf = Vec::new();
+    io::stdin().read_to_end(&mut buf).unwrap();
+}
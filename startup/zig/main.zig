@@ -0,0 +1,12 @@
+const std = @import("std");
+
+// Reports the wall-clock time main was entered, then idles until stdin is
+// closed so the runner can sample the process's memory.
+pub fn main() !void {
+    const now = std.time.nanoTimestamp();
+    try std.io.getStdOut().writer().print("main {d}\n", .{now});
+
+    var buf: [64]u8 = undefined;
+    const stdin = std.io.getStdIn().reader();
+    while (try stdin.read(&buf) > 0) {}
+}